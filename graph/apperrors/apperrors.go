@@ -0,0 +1,87 @@
+// Package apperrors は、クライアントに返すエラーの種類(extensions.code)を表す
+package apperrors
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+type Code string
+
+const (
+	NotFound        Code = "NOT_FOUND"
+	Forbidden       Code = "FORBIDDEN"
+	Conflict        Code = "CONFLICT"
+	Validation      Code = "VALIDATION"
+	Unauthenticated Code = "UNAUTHENTICATED"
+	Internal        Code = "INTERNAL"
+)
+
+// Error は、クライアントにそのまま見せてよいメッセージとコードを持つエラー
+type Error struct {
+	Code    Code
+	Message string
+	err     error
+}
+
+func (e *Error) Error() string {
+	if e.err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.err)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func New(code Code, format string, args ...interface{}) *Error {
+	return &Error{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// Wrap は、errを原因として保持したままコードとメッセージを付ける
+// errの内容はクライアントには見せない
+func Wrap(err error, code Code, format string, args ...interface{}) *Error {
+	return &Error{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+		err:     err,
+	}
+}
+
+// From は、errをコード付きのエラーに分類する
+// どれにも当てはまらないものはInternalになる
+func From(err error) *Error {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return Wrap(err, NotFound, "not found")
+	}
+
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code == sqlite3.ErrConstraint {
+		switch sqliteErr.ExtendedCode {
+		case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+			return Wrap(err, Conflict, "already exists")
+		case sqlite3.ErrConstraintForeignKey:
+			return Wrap(err, NotFound, "referenced object not found")
+		default:
+			return Wrap(err, Validation, "invalid input")
+		}
+	}
+
+	return Wrap(err, Internal, "internal error")
+}
+
+func IsNotFound(err error) bool {
+	return err != nil && From(err).Code == NotFound
+}
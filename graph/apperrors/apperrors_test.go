@@ -0,0 +1,101 @@
+package apperrors_test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+
+	"github.com/friendsofgo/errors"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqliteError は、実際にsqliteで制約違反を起こしてそのエラーを返す
+func sqliteError(t *testing.T, query string) error {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:?_foreign_keys=on")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := db.Exec(`
+CREATE TABLE parents(id TEXT PRIMARY KEY NOT NULL);
+CREATE TABLE children(
+	id TEXT PRIMARY KEY NOT NULL,
+	parent TEXT NOT NULL,
+	FOREIGN KEY (parent) REFERENCES parents(id)
+);
+INSERT INTO parents(id) VALUES ('P_1');
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.Exec(query)
+	if err == nil {
+		t.Fatalf("%s should fail", query)
+	}
+	// sqlboilerと同じようにラップしておく
+	return errors.Wrap(err, "db: unable to insert")
+}
+
+func TestFrom(t *testing.T) {
+	tests := []struct {
+		title   string
+		err     func(t *testing.T) error
+		code    apperrors.Code
+		message string
+	}{
+		{
+			title:   "no rows",
+			err:     func(t *testing.T) error { return fmt.Errorf("find: %w", sql.ErrNoRows) },
+			code:    apperrors.NotFound,
+			message: "not found",
+		},
+		{
+			title:   "foreign key",
+			err:     func(t *testing.T) error { return sqliteError(t, `INSERT INTO children VALUES ('C_1', 'P_2')`) },
+			code:    apperrors.NotFound,
+			message: "referenced object not found",
+		},
+		{
+			title:   "primary key",
+			err:     func(t *testing.T) error { return sqliteError(t, `INSERT INTO parents VALUES ('P_1')`) },
+			code:    apperrors.Conflict,
+			message: "already exists",
+		},
+		{
+			title:   "not null",
+			err:     func(t *testing.T) error { return sqliteError(t, `INSERT INTO parents VALUES (NULL)`) },
+			code:    apperrors.Validation,
+			message: "invalid input",
+		},
+		{
+			title:   "app error",
+			err:     func(t *testing.T) error { return fmt.Errorf("wrap: %w", apperrors.New(apperrors.Forbidden, "denied")) },
+			code:    apperrors.Forbidden,
+			message: "denied",
+		},
+		{
+			title:   "unknown",
+			err:     func(t *testing.T) error { return errors.New("sql: database is closed") },
+			code:    apperrors.Internal,
+			message: "internal error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			got := apperrors.From(tt.err(t))
+			if got.Code != tt.code {
+				t.Errorf("Code = %s, want %s", got.Code, tt.code)
+			}
+			if got.Message != tt.message {
+				t.Errorf("Message = %q, want %q", got.Message, tt.message)
+			}
+		})
+	}
+}
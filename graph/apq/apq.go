@@ -0,0 +1,107 @@
+// Package apq は、Automatic Persisted Queriesのクエリ本文をSQLiteに保存するストアを提供する
+// メモリ上のLRUと違い、サーバーを再起動しても登録済みのクエリは失われない
+package apq
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/saki-engineering/graphql-sample/graph/db"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type Store struct {
+	exec boil.ContextExecutor
+}
+
+var _ graphql.Cache[string] = (*Store)(nil)
+
+func NewStore(exec boil.ContextExecutor) *Store {
+	return &Store{exec: exec}
+}
+
+// Get は、ハッシュに対応するクエリ本文を返し、使用回数を1増やす
+// DBのエラーはキャッシュミスとして扱い、クライアントにクエリ本文を再送させる
+func (s *Store) Get(ctx context.Context, hash string) (string, bool) {
+	pq, err := db.FindPersistedQuery(ctx, s.exec, hash,
+		db.PersistedQueryColumns.Hash,
+		db.PersistedQueryColumns.Query,
+	)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Println("failed to get persisted query:", err)
+		}
+		return "", false
+	}
+
+	_, err = queries.Raw(
+		"UPDATE persisted_queries SET use_count = use_count + 1, last_used_at = DATETIME('now','localtime') WHERE hash = ?",
+		hash,
+	).ExecContext(ctx, s.exec)
+	if err != nil {
+		// 使用回数の更新に失敗しても、クエリの実行は続ける
+		log.Println("failed to count persisted query usage:", err)
+	}
+	return pq.Query, true
+}
+
+// Add は、クエリ本文を登録する
+// 同じハッシュが同時に登録された場合は、先に登録されたものを残す
+func (s *Store) Add(ctx context.Context, hash string, query string) {
+	pq := &db.PersistedQuery{
+		Hash:     hash,
+		Query:    query,
+		UseCount: 1,
+	}
+	if err := pq.Upsert(ctx, s.exec, false, []string{db.PersistedQueryColumns.Hash}, boil.None(), boil.Infer()); err != nil {
+		log.Println("failed to add persisted query:", err)
+	}
+}
+
+// List は、登録済みのクエリを使用回数の多い順に返す
+func (s *Store) List(ctx context.Context) (db.PersistedQuerySlice, error) {
+	return db.PersistedQueries(
+		qm.OrderBy(db.PersistedQueryColumns.UseCount+" desc"),
+	).All(ctx, s.exec)
+}
+
+// AdminHandler は、登録済みのクエリと使用回数をJSONで返す
+// サイトの管理者だけが見られる。認証はauth.AuthMiddlewareで済ませておく
+func (s *Store) AdminHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		viewer, ok := auth.GetUser(req.Context())
+		if err := policy.Authorize(policy.PersistedQueryRead, policy.Facts{Viewer: viewer}); err != nil {
+			if !ok {
+				http.Error(w, `{"reason": "not authenticated"}`, http.StatusUnauthorized)
+				return
+			}
+			http.Error(w, `{"reason": "forbidden"}`, http.StatusForbidden)
+			return
+		}
+
+		pqs, err := s.List(req.Context())
+		if err != nil {
+			log.Println(err)
+			http.Error(w, `{"reason": "internal error"}`, http.StatusInternalServerError)
+			return
+		}
+		if pqs == nil {
+			pqs = db.PersistedQuerySlice{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(pqs); err != nil {
+			log.Println(err)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package apq_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apq"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/internal/testutil"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+)
+
+func newStore(t *testing.T) *apq.Store {
+	t.Helper()
+	return apq.NewStore(testutil.NewDB(t))
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t)
+
+	if _, ok := store.Get(ctx, "hash1"); ok {
+		t.Fatal("want miss before Add")
+	}
+
+	store.Add(ctx, "hash1", "{ a }")
+	store.Add(ctx, "hash2", "{ b }")
+	// 同じハッシュで登録し直しても、先に登録されたものが残る
+	store.Add(ctx, "hash2", "{ c }")
+
+	for i := 0; i < 2; i++ {
+		query, ok := store.Get(ctx, "hash2")
+		if !ok || query != "{ b }" {
+			t.Fatalf("want { b }, but got %q (ok=%v)", query, ok)
+		}
+	}
+
+	pqs, err := store.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pqs) != 2 {
+		t.Fatalf("want 2 queries, but got %d", len(pqs))
+	}
+	// 使用回数の多い順に並ぶ
+	if pqs[0].Hash != "hash2" || pqs[0].UseCount != 3 {
+		t.Errorf("want hash2 used 3 times, but got %s used %d times", pqs[0].Hash, pqs[0].UseCount)
+	}
+	if pqs[1].Hash != "hash1" || pqs[1].UseCount != 1 {
+		t.Errorf("want hash1 used once, but got %s used %d times", pqs[1].Hash, pqs[1].UseCount)
+	}
+}
+
+func TestAdminHandler(t *testing.T) {
+	store := newStore(t)
+	store.Add(context.Background(), "hash1", "{ a }")
+
+	serve := func(user *model.User) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/admin/persisted-queries", nil)
+		if user != nil {
+			req = req.WithContext(auth.WithUser(req.Context(), user, scope.All))
+		}
+		rec := httptest.NewRecorder()
+		store.AdminHandler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	// サイトの管理者以外には見せない
+	if rec := serve(nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("anonymous: want 401, but got %d", rec.Code)
+	}
+	if rec := serve(&model.User{ID: "U_1"}); rec.Code != http.StatusForbidden {
+		t.Errorf("non-admin: want 403, but got %d", rec.Code)
+	}
+
+	rec := serve(&model.User{ID: "U_2", IsSiteAdmin: true})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("want 200, but got %d", rec.Code)
+	}
+
+	var got []struct {
+		Hash     string `json:"hash"`
+		Query    string `json:"query"`
+		UseCount int64  `json:"use_count"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Hash != "hash1" || got[0].Query != "{ a }" || got[0].UseCount != 1 {
+		t.Errorf("unexpected response: %+v", got)
+	}
+}
@@ -0,0 +1,240 @@
+// Package audit は、実行された全てのmutationを記録するgqlgenのAroundOperationsのフックを提供する
+// 誰が・いつ・どのノードに対して・何をして・どうなったかを、後から管理者が調べられるようにする
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Recorder は、mutationの記録を保存する
+type Recorder interface {
+	RecordAuditLog(ctx context.Context, entry *model.AuditLogEntry) error
+}
+
+type Logger struct {
+	recorder Recorder
+	now      func() time.Time
+}
+
+func New(recorder Recorder) *Logger {
+	return &Logger{recorder: recorder, now: time.Now}
+}
+
+// AroundOperations は、mutationの結果が出てから記録する。srv.AroundOperationsに渡して使う
+// 記録に失敗してもmutationは既に実行されているので、結果はそのまま返してログに残す
+func (l *Logger) AroundOperations(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Mutation {
+		return next(ctx)
+	}
+
+	handler := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		resp := handler(ctx)
+		if resp == nil {
+			return nil
+		}
+		entry := l.entry(ctx, opCtx, resp)
+		// クライアントが切断していても記録は残す
+		if err := l.recorder.RecordAuditLog(context.WithoutCancel(ctx), entry); err != nil {
+			log.Printf("failed to record audit log (operation=%s): %v", opCtx.Operation.Name, err)
+		}
+		return resp
+	}
+}
+
+func (l *Logger) entry(ctx context.Context, opCtx *graphql.OperationContext, resp *graphql.Response) *model.AuditLogEntry {
+	variables, err := json.Marshal(redact(opCtx.Variables))
+	if err != nil {
+		variables = []byte("{}")
+	}
+	var data interface{}
+	if len(resp.Data) != 0 {
+		json.Unmarshal(resp.Data, &data)
+	}
+
+	ids := nodeIDs{}
+	// 変数だけでなくクエリに直接書かれた引数も見るため、解決済みの引数から集める
+	for _, selection := range opCtx.Operation.SelectionSet {
+		if field, ok := selection.(*ast.Field); ok {
+			ids.fromArguments(field.ArgumentMap(opCtx.Variables))
+		}
+	}
+	ids.fromResponse(data)
+
+	entry := &model.AuditLogEntry{
+		Variables:  string(variables),
+		NodeIds:    ids.list,
+		Outcome:    outcome(resp, data),
+		DurationMs: int(l.now().Sub(opCtx.Stats.OperationStart).Milliseconds()),
+	}
+	if name := opCtx.Operation.Name; name != "" {
+		entry.OperationName = &name
+	}
+	if actor, ok := auth.GetUser(ctx); ok {
+		entry.Actor = actor
+	}
+	if admin, ok := auth.GetImpersonator(ctx); ok {
+		entry.Impersonator = admin
+	}
+	return entry
+}
+
+const redacted = "[REDACTED]"
+
+// secretWords は、名前にこれらを含む変数の値を記録しない
+var secretWords = []string{"password", "token", "secret"}
+
+func isSecret(key string) bool {
+	key = strings.ToLower(key)
+	for _, word := range secretWords {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// redact は、秘密の値を伏せたvのコピーを返す
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			if isSecret(key) {
+				result[key] = redacted
+				continue
+			}
+			result[key] = redact(value)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for _, value := range v {
+			result = append(result, redact(value))
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// nodeIDs は、重複を除いて見つけた順にノードのIDを集める
+type nodeIDs struct {
+	seen map[string]bool
+	list []string
+}
+
+func (n *nodeIDs) add(v interface{}) {
+	id, ok := v.(string)
+	if !ok {
+		return
+	}
+	// clientMutationIdなど、グローバルIDでない値は含めない
+	if _, err := globalid.Decode(id); err != nil {
+		return
+	}
+	if n.seen == nil {
+		n.seen = map[string]bool{}
+	}
+	if !n.seen[id] {
+		n.seen[id] = true
+		n.list = append(n.list, id)
+	}
+}
+
+// isIDKey は、projectId・contentIdsのように、IDを入れる引数の名前かを返す
+func isIDKey(key string) bool {
+	if key == "clientMutationId" {
+		return false
+	}
+	return key == "id" || key == "ids" || strings.HasSuffix(key, "Id") || strings.HasSuffix(key, "Ids")
+}
+
+func (n *nodeIDs) fromArguments(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isIDKey(key) {
+				n.addAll(value)
+				continue
+			}
+			n.fromArguments(value)
+		}
+	case []interface{}:
+		for _, value := range v {
+			n.fromArguments(value)
+		}
+	}
+}
+
+func (n *nodeIDs) addAll(v interface{}) {
+	if values, ok := v.([]interface{}); ok {
+		for _, value := range values {
+			n.add(value)
+		}
+		return
+	}
+	n.add(v)
+}
+
+// fromResponse は、結果に含まれるノードのidフィールドを集める
+func (n *nodeIDs) fromResponse(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if key == "id" {
+				n.add(value)
+				continue
+			}
+			n.fromResponse(value)
+		}
+	case []interface{}:
+		for _, value := range v {
+			n.fromResponse(value)
+		}
+	}
+}
+
+// outcome は、トップレベルのエラーがあればERROR、ペイロードのuserErrorsがあればUSER_ERRORとする
+func outcome(resp *graphql.Response, data interface{}) model.AuditLogOutcome {
+	if len(resp.Errors) != 0 {
+		return model.AuditLogOutcomeError
+	}
+	if hasUserErrors(data) {
+		return model.AuditLogOutcomeUserError
+	}
+	return model.AuditLogOutcomeSuccess
+}
+
+func hasUserErrors(v interface{}) bool {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if errs, ok := value.([]interface{}); ok && key == "userErrors" && len(errs) != 0 {
+				return true
+			}
+			if hasUserErrors(value) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if hasUserErrors(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
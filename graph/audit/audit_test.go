@@ -0,0 +1,132 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/audit"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var schema = gqlparser.MustLoadSchema(&ast.Source{Input: `
+type Query { ok: Boolean }
+input AddItemInput {
+  clientMutationId: String
+  projectId: ID!
+  contentId: ID!
+  password: String
+}
+type Item { id: ID! }
+type UserError { message: String! }
+type AddItemPayload { item: Item, userErrors: [UserError!]! }
+type Mutation { addItem(input: AddItemInput!): AddItemPayload }
+`})
+
+type recorder struct {
+	entries []*model.AuditLogEntry
+}
+
+func (r *recorder) RecordAuditLog(ctx context.Context, entry *model.AuditLogEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+// run は、queryを実行した結果としてrespを返したものとして、フックを通す
+func run(t *testing.T, ctx context.Context, l *audit.Logger, query string, variables map[string]interface{}, resp *graphql.Response) {
+	t.Helper()
+	doc, errs := gqlparser.LoadQuery(schema, query)
+	if errs != nil {
+		t.Fatal(errs)
+	}
+	opCtx := &graphql.OperationContext{
+		RawQuery:  query,
+		Variables: variables,
+		Doc:       doc,
+		Operation: doc.Operations[0],
+		Stats:     graphql.Stats{OperationStart: time.Now()},
+	}
+	ctx = graphql.WithOperationContext(ctx, opCtx)
+	handler := l.AroundOperations(ctx, func(ctx context.Context) graphql.ResponseHandler {
+		return graphql.OneShot(resp)
+	})
+	if got := handler(ctx); got != resp {
+		t.Errorf("response was replaced: %+v", got)
+	}
+}
+
+func TestRecordsMutation(t *testing.T) {
+	r := &recorder{}
+	l := audit.New(r)
+	ctx := auth.WithUser(context.Background(), &model.User{ID: "U_1"}, scope.All)
+
+	run(t, ctx, l,
+		`mutation AddItem($input: AddItemInput!) { addItem(input: $input) { item { id } userErrors { message } } }`,
+		map[string]interface{}{"input": map[string]interface{}{
+			"clientMutationId": "PJ_999",
+			"projectId":        "PJ_1",
+			"contentId":        "ISSUE_1",
+			"password":         "hunter22",
+		}},
+		&graphql.Response{Data: json.RawMessage(`{"addItem":{"item":{"id":"PJI_1"},"userErrors":[]}}`)},
+	)
+	// クエリに直接書かれた引数のIDも拾う
+	run(t, ctx, l,
+		`mutation { addItem(input: {projectId: "PJ_2", contentId: "PR_1"}) { userErrors { message } } }`,
+		nil,
+		&graphql.Response{Data: json.RawMessage(`{"addItem":{"userErrors":[{"message":"not found"}]}}`)},
+	)
+	run(t, ctx, l,
+		`mutation { addItem(input: {projectId: "PJ_2", contentId: "PR_1"}) { userErrors { message } } }`,
+		nil,
+		&graphql.Response{Errors: gqlerror.List{{Message: "internal error"}}, Data: json.RawMessage(`{"addItem":null}`)},
+	)
+	// queryは記録しない
+	run(t, ctx, l, `{ ok }`, nil, &graphql.Response{Data: json.RawMessage(`{"ok":true}`)})
+
+	if len(r.entries) != 3 {
+		t.Fatalf("want 3 entries, but got %d", len(r.entries))
+	}
+
+	got := r.entries[0]
+	if got.Actor.ID != "U_1" || got.Impersonator != nil || *got.OperationName != "AddItem" || got.Outcome != model.AuditLogOutcomeSuccess {
+		t.Errorf("unexpected entry: %+v", got)
+	}
+	if diff := cmp.Diff([]string{"ISSUE_1", "PJI_1", "PJ_1"}, sorted(got.NodeIds)); diff != "" {
+		t.Errorf("node ids mismatch (-want +got):\n%s", diff)
+	}
+	var variables map[string]map[string]string
+	if err := json.Unmarshal([]byte(got.Variables), &variables); err != nil {
+		t.Fatal(err)
+	}
+	if variables["input"]["password"] != "[REDACTED]" || variables["input"]["projectId"] != "PJ_1" {
+		t.Errorf("unexpected variables: %s", got.Variables)
+	}
+
+	if got := r.entries[1]; got.Outcome != model.AuditLogOutcomeUserError || got.OperationName != nil {
+		t.Errorf("unexpected entry: %+v", got)
+	}
+	if diff := cmp.Diff([]string{"PJ_2", "PR_1"}, sorted(r.entries[1].NodeIds)); diff != "" {
+		t.Errorf("node ids mismatch (-want +got):\n%s", diff)
+	}
+	if got := r.entries[2]; got.Outcome != model.AuditLogOutcomeError {
+		t.Errorf("unexpected entry: %+v", got)
+	}
+}
+
+// sorted は、引数のmapを回す順序が決まっていないため、比べる前に並べ替える
+func sorted(ids []string) []string {
+	result := append([]string{}, ids...)
+	sort.Strings(result)
+	return result
+}
@@ -1,35 +1,95 @@
 package graph
 
 import (
+	"context"
+
+	"github.com/saki-engineering/graphql-sample/graph/model"
 	"github.com/saki-engineering/graphql-sample/internal"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 )
 
-func ComplexityConfig() internal.ComplexityRoot {
+// DefaultComplexityLimit は、1つのオペレーションに許すコストの上限のデフォルト値
+const DefaultComplexityLimit = 1000
+
+// ComplexityConfig は、各フィールドのコストの計算方法を返す
+// connectionのページサイズは、maxPageSizeを上限として数える
+func ComplexityConfig(maxPageSize int) internal.ComplexityRoot {
 	var c internal.ComplexityRoot
+	connection := func(childComplexity int, after *string, before *string, first *int, last *int) int {
+		return connectionComplexity(maxPageSize, childComplexity, first, last)
+	}
 
 	c.Query.Node = func(childComplexity int, id string) int {
-		return 1
+		return 1 + childComplexity
+	}
+	// 空のリストでも1回の呼び出し分はかかる。上限を超えるIDの数はリゾルバが拒否する
+	c.Query.Nodes = func(childComplexity int, ids []string) int {
+		return 1 + min(len(ids), maxPageSize)*childComplexity
 	}
 	c.ProjectV2.Title = func(childComplexity int) int {
 		return 1
 	}
-	c.Repository.Issues = func(childComplexity int, after *string, before *string, first *int, last *int) int {
-		var cnt int
-		switch {
-		case first != nil && last != nil:
-			if *first < *last {
-				cnt = *last
-			} else {
-				cnt = *first
-			}
-		case first != nil && last == nil:
-			cnt = *first
-		case first == nil && last != nil:
+
+	// connectionは、要求されたページサイズ分だけ子のコストがかかる
+	c.Repository.Issues = connection
+	c.Repository.PullRequests = connection
+	c.User.ProjectV2s = connection
+	c.ProjectV2.Items = connection
+	c.Issue.ProjectItems = connection
+	c.PullRequest.ProjectItems = connection
+	c.Query.AuditLog = func(childComplexity int, filter *model.AuditLogFilter, first *int, after *string) int {
+		return connectionComplexity(maxPageSize, childComplexity, first, nil)
+	}
+	return c
+}
+
+// connectionComplexity は、要求されたページサイズ分の子のコストを返す
+// 負の値で他のフィールドのコストを打ち消せないよう、ページサイズは1からmaxPageSizeの範囲に収める
+func connectionComplexity(maxPageSize, childComplexity int, first *int, last *int) int {
+	var cnt int
+	switch {
+	case first != nil && last != nil:
+		if *first < *last {
 			cnt = *last
-		default:
-			cnt = 1
+		} else {
+			cnt = *first
 		}
-		return cnt * childComplexity
+	case first != nil && last == nil:
+		cnt = *first
+	case first == nil && last != nil:
+		cnt = *last
+	default:
+		cnt = 1
 	}
-	return c
+	if cnt < 1 {
+		cnt = 1
+	}
+	if cnt > maxPageSize {
+		cnt = maxPageSize
+	}
+	return cnt * childComplexity
+}
+
+// ReportComplexity は、計算したオペレーションのコストをレスポンスのextensionsに載せる
+// srv.AroundResponsesに渡して使う
+func ReportComplexity(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if resp == nil || !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+	stats := extension.GetComplexityStats(ctx)
+	if stats == nil {
+		return resp
+	}
+
+	if resp.Extensions == nil {
+		resp.Extensions = map[string]interface{}{}
+	}
+	resp.Extensions["complexity"] = map[string]int{
+		"cost":  stats.Complexity,
+		"limit": stats.ComplexityLimit,
+	}
+	return resp
 }
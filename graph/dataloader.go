@@ -2,61 +2,92 @@ package graph
 
 import (
 	"context"
-	"errors"
 
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
 	"github.com/saki-engineering/graphql-sample/graph/model"
 	"github.com/saki-engineering/graphql-sample/graph/services"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/graph-gophers/dataloader/v7"
 )
 
+var errNodeNotFound = apperrors.New(apperrors.NotFound, "not found")
+
 type Loaders struct {
-	UserLoader dataloader.Interface[string, *model.User]
+	UserLoader        dataloader.Interface[string, *model.User]
+	RepoLoader        dataloader.Interface[string, *model.Repository]
+	IssueLoader       dataloader.Interface[string, *model.Issue]
+	PullRequestLoader dataloader.Interface[string, *model.PullRequest]
+	ProjectLoader     dataloader.Interface[string, *model.ProjectV2]
+	ProjectItemLoader dataloader.Interface[string, *model.ProjectV2Item]
 }
 
 func NewLoaders(Srv services.Services) *Loaders {
-	userBatcher := &userBatcher{Srv: Srv}
+	userBatcher := &nodeBatcher[*model.User]{list: Srv.ListUsersByID}
+	repoBatcher := &nodeBatcher[*model.Repository]{list: Srv.ListReposByID}
+	issueBatcher := &nodeBatcher[*model.Issue]{list: Srv.ListIssuesByID}
+	pullRequestBatcher := &nodeBatcher[*model.PullRequest]{list: Srv.ListPullRequestsByID}
+	projectBatcher := &nodeBatcher[*model.ProjectV2]{list: Srv.ListProjectsByID}
+	projectItemBatcher := &nodeBatcher[*model.ProjectV2Item]{list: Srv.ListProjectItemsByID}
 
 	return &Loaders{
 		// dataloader.Loader[string, *model.User]型
-		UserLoader: dataloader.NewBatchedLoader[string, *model.User](userBatcher.BatchGetUsers),
+		UserLoader:        dataloader.NewBatchedLoader[string, *model.User](userBatcher.BatchGet),
+		RepoLoader:        dataloader.NewBatchedLoader[string, *model.Repository](repoBatcher.BatchGet),
+		IssueLoader:       dataloader.NewBatchedLoader[string, *model.Issue](issueBatcher.BatchGet),
+		PullRequestLoader: dataloader.NewBatchedLoader[string, *model.PullRequest](pullRequestBatcher.BatchGet),
+		ProjectLoader:     dataloader.NewBatchedLoader[string, *model.ProjectV2](projectBatcher.BatchGet),
+		ProjectItemLoader: dataloader.NewBatchedLoader[string, *model.ProjectV2Item](projectItemBatcher.BatchGet),
 	}
 }
 
-type userBatcher struct {
-	Srv services.Services
+type loadersKey struct{}
+
+// WithLoaders は、オペレーションごとに新しいLoaderを用意するためのAroundOperations
+// 見えるリポジトリは閲覧者ごとに違うので、Loaderのキャッシュを閲覧者の間で共有しない
+func (r *Resolver) WithLoaders(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	return next(context.WithValue(ctx, loadersKey{}, NewLoaders(r.Srv)))
+}
+
+// loaders は、オペレーションのLoaderを返す
+// WithLoadersを通っていない場合は、Resolverに設定されたLoaderを使う
+func (r *Resolver) loaders(ctx context.Context) *Loaders {
+	if loaders, ok := ctx.Value(loadersKey{}).(*Loaders); ok {
+		return loaders
+	}
+	if r.Loaders != nil {
+		return r.Loaders
+	}
+	return NewLoaders(r.Srv)
+}
+
+// nodeBatcher は、IDのリストを受け取って1回のクエリでまとめてノードを取得する
+type nodeBatcher[T model.Node] struct {
+	list func(ctx context.Context, IDs []string) ([]T, error)
 }
 
 // github.com/graph-gophers/dataloader/v7 の type BatchFunc[K, V]を満たすため
 // dataloader.NewBatchedLoader関数の引数にできる
-func (u *userBatcher) BatchGetUsers(ctx context.Context, IDs []string) []*dataloader.Result[*model.User] {
+func (b *nodeBatcher[T]) BatchGet(ctx context.Context, IDs []string) []*dataloader.Result[T] {
 	// 引数と戻り値のスライスlenは等しくする
-	results := make([]*dataloader.Result[*model.User], len(IDs))
-	for i := range results {
-		results[i] = &dataloader.Result[*model.User]{
-			Error: errors.New("not found"),
+	results := make([]*dataloader.Result[T], len(IDs))
+
+	nodes, err := b.list(ctx, IDs)
+	if err != nil {
+		for i := range results {
+			results[i] = &dataloader.Result[T]{Error: err}
 		}
+		return results
 	}
 
 	indexs := make(map[string]int, len(IDs))
 	for i, ID := range IDs {
 		indexs[ID] = i
+		results[i] = &dataloader.Result[T]{Error: errNodeNotFound}
 	}
-
-	users, err := u.Srv.ListUsersByID(ctx, IDs)
-	for _, user := range users {
-		var rsl *dataloader.Result[*model.User]
-		if err != nil {
-			rsl = &dataloader.Result[*model.User]{
-				Error: err,
-			}
-		} else {
-			rsl = &dataloader.Result[*model.User]{
-				Data: user,
-			}
-		}
+	for _, node := range nodes {
 		// 該当するIDと同じindexに格納する
-		results[indexs[user.ID]] = rsl
+		results[indexs[node.GetID()]] = &dataloader.Result[T]{Data: node}
 	}
 	return results
 }
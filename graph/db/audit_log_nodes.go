@@ -0,0 +1,1106 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AuditLogNode is an object representing the database table.
+type AuditLogNode struct {
+	AuditLog string `boil:"audit_log" json:"audit_log" toml:"audit_log" yaml:"audit_log"`
+	NodeID   string `boil:"node_id" json:"node_id" toml:"node_id" yaml:"node_id"`
+
+	R *auditLogNodeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L auditLogNodeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AuditLogNodeColumns = struct {
+	AuditLog string
+	NodeID   string
+}{
+	AuditLog: "audit_log",
+	NodeID:   "node_id",
+}
+
+var AuditLogNodeTableColumns = struct {
+	AuditLog string
+	NodeID   string
+}{
+	AuditLog: "audit_log_nodes.audit_log",
+	NodeID:   "audit_log_nodes.node_id",
+}
+
+// Generated where
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var AuditLogNodeWhere = struct {
+	AuditLog whereHelperstring
+	NodeID   whereHelperstring
+}{
+	AuditLog: whereHelperstring{field: "\"audit_log_nodes\".\"audit_log\""},
+	NodeID:   whereHelperstring{field: "\"audit_log_nodes\".\"node_id\""},
+}
+
+// AuditLogNodeRels is where relationship names are stored.
+var AuditLogNodeRels = struct {
+	AuditLogNodeAuditLog string
+}{
+	AuditLogNodeAuditLog: "AuditLogNodeAuditLog",
+}
+
+// auditLogNodeR is where relationships are stored.
+type auditLogNodeR struct {
+	AuditLogNodeAuditLog *AuditLog `boil:"AuditLogNodeAuditLog" json:"AuditLogNodeAuditLog" toml:"AuditLogNodeAuditLog" yaml:"AuditLogNodeAuditLog"`
+}
+
+// NewStruct creates a new relationship struct
+func (*auditLogNodeR) NewStruct() *auditLogNodeR {
+	return &auditLogNodeR{}
+}
+
+func (r *auditLogNodeR) GetAuditLogNodeAuditLog() *AuditLog {
+	if r == nil {
+		return nil
+	}
+	return r.AuditLogNodeAuditLog
+}
+
+// auditLogNodeL is where Load methods for each relationship are stored.
+type auditLogNodeL struct{}
+
+var (
+	auditLogNodeAllColumns            = []string{"audit_log", "node_id"}
+	auditLogNodeColumnsWithoutDefault = []string{"audit_log", "node_id"}
+	auditLogNodeColumnsWithDefault    = []string{}
+	auditLogNodePrimaryKeyColumns     = []string{"audit_log", "node_id"}
+	auditLogNodeGeneratedColumns      = []string{}
+)
+
+type (
+	// AuditLogNodeSlice is an alias for a slice of pointers to AuditLogNode.
+	// This should almost always be used instead of []AuditLogNode.
+	AuditLogNodeSlice []*AuditLogNode
+	// AuditLogNodeHook is the signature for custom AuditLogNode hook methods
+	AuditLogNodeHook func(context.Context, boil.ContextExecutor, *AuditLogNode) error
+
+	auditLogNodeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	auditLogNodeType                 = reflect.TypeOf(&AuditLogNode{})
+	auditLogNodeMapping              = queries.MakeStructMapping(auditLogNodeType)
+	auditLogNodePrimaryKeyMapping, _ = queries.BindMapping(auditLogNodeType, auditLogNodeMapping, auditLogNodePrimaryKeyColumns)
+	auditLogNodeInsertCacheMut       sync.RWMutex
+	auditLogNodeInsertCache          = make(map[string]insertCache)
+	auditLogNodeUpdateCacheMut       sync.RWMutex
+	auditLogNodeUpdateCache          = make(map[string]updateCache)
+	auditLogNodeUpsertCacheMut       sync.RWMutex
+	auditLogNodeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var auditLogNodeAfterSelectHooks []AuditLogNodeHook
+
+var auditLogNodeBeforeInsertHooks []AuditLogNodeHook
+var auditLogNodeAfterInsertHooks []AuditLogNodeHook
+
+var auditLogNodeBeforeUpdateHooks []AuditLogNodeHook
+var auditLogNodeAfterUpdateHooks []AuditLogNodeHook
+
+var auditLogNodeBeforeDeleteHooks []AuditLogNodeHook
+var auditLogNodeAfterDeleteHooks []AuditLogNodeHook
+
+var auditLogNodeBeforeUpsertHooks []AuditLogNodeHook
+var auditLogNodeAfterUpsertHooks []AuditLogNodeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AuditLogNode) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogNodeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AuditLogNode) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogNodeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AuditLogNode) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogNodeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AuditLogNode) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogNodeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AuditLogNode) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogNodeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AuditLogNode) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogNodeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AuditLogNode) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogNodeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AuditLogNode) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogNodeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AuditLogNode) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogNodeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAuditLogNodeHook registers your hook function for all future operations.
+func AddAuditLogNodeHook(hookPoint boil.HookPoint, auditLogNodeHook AuditLogNodeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		auditLogNodeAfterSelectHooks = append(auditLogNodeAfterSelectHooks, auditLogNodeHook)
+	case boil.BeforeInsertHook:
+		auditLogNodeBeforeInsertHooks = append(auditLogNodeBeforeInsertHooks, auditLogNodeHook)
+	case boil.AfterInsertHook:
+		auditLogNodeAfterInsertHooks = append(auditLogNodeAfterInsertHooks, auditLogNodeHook)
+	case boil.BeforeUpdateHook:
+		auditLogNodeBeforeUpdateHooks = append(auditLogNodeBeforeUpdateHooks, auditLogNodeHook)
+	case boil.AfterUpdateHook:
+		auditLogNodeAfterUpdateHooks = append(auditLogNodeAfterUpdateHooks, auditLogNodeHook)
+	case boil.BeforeDeleteHook:
+		auditLogNodeBeforeDeleteHooks = append(auditLogNodeBeforeDeleteHooks, auditLogNodeHook)
+	case boil.AfterDeleteHook:
+		auditLogNodeAfterDeleteHooks = append(auditLogNodeAfterDeleteHooks, auditLogNodeHook)
+	case boil.BeforeUpsertHook:
+		auditLogNodeBeforeUpsertHooks = append(auditLogNodeBeforeUpsertHooks, auditLogNodeHook)
+	case boil.AfterUpsertHook:
+		auditLogNodeAfterUpsertHooks = append(auditLogNodeAfterUpsertHooks, auditLogNodeHook)
+	}
+}
+
+// One returns a single auditLogNode record from the query.
+func (q auditLogNodeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AuditLogNode, error) {
+	o := &AuditLogNode{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for audit_log_nodes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AuditLogNode records from the query.
+func (q auditLogNodeQuery) All(ctx context.Context, exec boil.ContextExecutor) (AuditLogNodeSlice, error) {
+	var o []*AuditLogNode
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to AuditLogNode slice")
+	}
+
+	if len(auditLogNodeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AuditLogNode records in the query.
+func (q auditLogNodeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count audit_log_nodes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q auditLogNodeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if audit_log_nodes exists")
+	}
+
+	return count > 0, nil
+}
+
+// AuditLogNodeAuditLog pointed to by the foreign key.
+func (o *AuditLogNode) AuditLogNodeAuditLog(mods ...qm.QueryMod) auditLogQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AuditLog),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return AuditLogs(queryMods...)
+}
+
+// LoadAuditLogNodeAuditLog allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (auditLogNodeL) LoadAuditLogNodeAuditLog(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuditLogNode interface{}, mods queries.Applicator) error {
+	var slice []*AuditLogNode
+	var object *AuditLogNode
+
+	if singular {
+		var ok bool
+		object, ok = maybeAuditLogNode.(*AuditLogNode)
+		if !ok {
+			object = new(AuditLogNode)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuditLogNode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuditLogNode))
+			}
+		}
+	} else {
+		s, ok := maybeAuditLogNode.(*[]*AuditLogNode)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuditLogNode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuditLogNode))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &auditLogNodeR{}
+		}
+		args = append(args, object.AuditLog)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &auditLogNodeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AuditLog {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AuditLog)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`audit_logs`),
+		qm.WhereIn(`audit_logs.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AuditLog")
+	}
+
+	var resultSlice []*AuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AuditLog")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for audit_logs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_logs")
+	}
+
+	if len(auditLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AuditLogNodeAuditLog = foreign
+		if foreign.R == nil {
+			foreign.R = &auditLogR{}
+		}
+		foreign.R.AuditLogNodes = append(foreign.R.AuditLogNodes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AuditLog == foreign.ID {
+				local.R.AuditLogNodeAuditLog = foreign
+				if foreign.R == nil {
+					foreign.R = &auditLogR{}
+				}
+				foreign.R.AuditLogNodes = append(foreign.R.AuditLogNodes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAuditLogNodeAuditLog of the auditLogNode to the related item.
+// Sets o.R.AuditLogNodeAuditLog to related.
+// Adds o to related.R.AuditLogNodes.
+func (o *AuditLogNode) SetAuditLogNodeAuditLog(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AuditLog) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"audit_log_nodes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"audit_log"}),
+		strmangle.WhereClause("\"", "\"", 0, auditLogNodePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.AuditLog, o.NodeID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AuditLog = related.ID
+	if o.R == nil {
+		o.R = &auditLogNodeR{
+			AuditLogNodeAuditLog: related,
+		}
+	} else {
+		o.R.AuditLogNodeAuditLog = related
+	}
+
+	if related.R == nil {
+		related.R = &auditLogR{
+			AuditLogNodes: AuditLogNodeSlice{o},
+		}
+	} else {
+		related.R.AuditLogNodes = append(related.R.AuditLogNodes, o)
+	}
+
+	return nil
+}
+
+// AuditLogNodes retrieves all the records using an executor.
+func AuditLogNodes(mods ...qm.QueryMod) auditLogNodeQuery {
+	mods = append(mods, qm.From("\"audit_log_nodes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"audit_log_nodes\".*"})
+	}
+
+	return auditLogNodeQuery{q}
+}
+
+// FindAuditLogNode retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAuditLogNode(ctx context.Context, exec boil.ContextExecutor, auditLog string, nodeID string, selectCols ...string) (*AuditLogNode, error) {
+	auditLogNodeObj := &AuditLogNode{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"audit_log_nodes\" where \"audit_log\"=? AND \"node_id\"=?", sel,
+	)
+
+	q := queries.Raw(query, auditLog, nodeID)
+
+	err := q.Bind(ctx, exec, auditLogNodeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from audit_log_nodes")
+	}
+
+	if err = auditLogNodeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return auditLogNodeObj, err
+	}
+
+	return auditLogNodeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AuditLogNode) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no audit_log_nodes provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogNodeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	auditLogNodeInsertCacheMut.RLock()
+	cache, cached := auditLogNodeInsertCache[key]
+	auditLogNodeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			auditLogNodeAllColumns,
+			auditLogNodeColumnsWithDefault,
+			auditLogNodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogNodeType, auditLogNodeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(auditLogNodeType, auditLogNodeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"audit_log_nodes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"audit_log_nodes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into audit_log_nodes")
+	}
+
+	if !cached {
+		auditLogNodeInsertCacheMut.Lock()
+		auditLogNodeInsertCache[key] = cache
+		auditLogNodeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AuditLogNode.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AuditLogNode) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	auditLogNodeUpdateCacheMut.RLock()
+	cache, cached := auditLogNodeUpdateCache[key]
+	auditLogNodeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			auditLogNodeAllColumns,
+			auditLogNodePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update audit_log_nodes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"audit_log_nodes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, auditLogNodePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(auditLogNodeType, auditLogNodeMapping, append(wl, auditLogNodePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update audit_log_nodes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for audit_log_nodes")
+	}
+
+	if !cached {
+		auditLogNodeUpdateCacheMut.Lock()
+		auditLogNodeUpdateCache[key] = cache
+		auditLogNodeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q auditLogNodeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for audit_log_nodes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for audit_log_nodes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AuditLogNodeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogNodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"audit_log_nodes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogNodePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in auditLogNode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all auditLogNode")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AuditLogNode) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no audit_log_nodes provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogNodeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	auditLogNodeUpsertCacheMut.RLock()
+	cache, cached := auditLogNodeUpsertCache[key]
+	auditLogNodeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			auditLogNodeAllColumns,
+			auditLogNodeColumnsWithDefault,
+			auditLogNodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			auditLogNodeAllColumns,
+			auditLogNodePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("db: unable to upsert audit_log_nodes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(auditLogNodePrimaryKeyColumns))
+			copy(conflict, auditLogNodePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"audit_log_nodes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogNodeType, auditLogNodeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(auditLogNodeType, auditLogNodeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "db: unable to upsert audit_log_nodes")
+	}
+
+	if !cached {
+		auditLogNodeUpsertCacheMut.Lock()
+		auditLogNodeUpsertCache[key] = cache
+		auditLogNodeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AuditLogNode record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AuditLogNode) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no AuditLogNode provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), auditLogNodePrimaryKeyMapping)
+	sql := "DELETE FROM \"audit_log_nodes\" WHERE \"audit_log\"=? AND \"node_id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from audit_log_nodes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for audit_log_nodes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q auditLogNodeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no auditLogNodeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from audit_log_nodes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for audit_log_nodes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AuditLogNodeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(auditLogNodeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogNodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"audit_log_nodes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogNodePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from auditLogNode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for audit_log_nodes")
+	}
+
+	if len(auditLogNodeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AuditLogNode) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAuditLogNode(ctx, exec, o.AuditLog, o.NodeID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuditLogNodeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AuditLogNodeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogNodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"audit_log_nodes\".* FROM \"audit_log_nodes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogNodePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in AuditLogNodeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AuditLogNodeExists checks if the AuditLogNode row exists.
+func AuditLogNodeExists(ctx context.Context, exec boil.ContextExecutor, auditLog string, nodeID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"audit_log_nodes\" where \"audit_log\"=? AND \"node_id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, auditLog, nodeID)
+	}
+	row := exec.QueryRowContext(ctx, sql, auditLog, nodeID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if audit_log_nodes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AuditLogNode row exists.
+func (o *AuditLogNode) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AuditLogNodeExists(ctx, exec, o.AuditLog, o.NodeID)
+}
@@ -0,0 +1,1225 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AuditLog is an object representing the database table.
+type AuditLog struct {
+	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Actor         null.String `boil:"actor" json:"actor,omitempty" toml:"actor" yaml:"actor,omitempty"`
+	Impersonator  null.String `boil:"impersonator" json:"impersonator,omitempty" toml:"impersonator" yaml:"impersonator,omitempty"`
+	OperationName string      `boil:"operation_name" json:"operation_name" toml:"operation_name" yaml:"operation_name"`
+	Variables     string      `boil:"variables" json:"variables" toml:"variables" yaml:"variables"`
+	Outcome       string      `boil:"outcome" json:"outcome" toml:"outcome" yaml:"outcome"`
+	DurationMS    int64       `boil:"duration_ms" json:"duration_ms" toml:"duration_ms" yaml:"duration_ms"`
+	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *auditLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L auditLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AuditLogColumns = struct {
+	ID            string
+	Actor         string
+	Impersonator  string
+	OperationName string
+	Variables     string
+	Outcome       string
+	DurationMS    string
+	CreatedAt     string
+}{
+	ID:            "id",
+	Actor:         "actor",
+	Impersonator:  "impersonator",
+	OperationName: "operation_name",
+	Variables:     "variables",
+	Outcome:       "outcome",
+	DurationMS:    "duration_ms",
+	CreatedAt:     "created_at",
+}
+
+var AuditLogTableColumns = struct {
+	ID            string
+	Actor         string
+	Impersonator  string
+	OperationName string
+	Variables     string
+	Outcome       string
+	DurationMS    string
+	CreatedAt     string
+}{
+	ID:            "audit_logs.id",
+	Actor:         "audit_logs.actor",
+	Impersonator:  "audit_logs.impersonator",
+	OperationName: "audit_logs.operation_name",
+	Variables:     "audit_logs.variables",
+	Outcome:       "audit_logs.outcome",
+	DurationMS:    "audit_logs.duration_ms",
+	CreatedAt:     "audit_logs.created_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AuditLogWhere = struct {
+	ID            whereHelperstring
+	Actor         whereHelpernull_String
+	Impersonator  whereHelpernull_String
+	OperationName whereHelperstring
+	Variables     whereHelperstring
+	Outcome       whereHelperstring
+	DurationMS    whereHelperint64
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"audit_logs\".\"id\""},
+	Actor:         whereHelpernull_String{field: "\"audit_logs\".\"actor\""},
+	Impersonator:  whereHelpernull_String{field: "\"audit_logs\".\"impersonator\""},
+	OperationName: whereHelperstring{field: "\"audit_logs\".\"operation_name\""},
+	Variables:     whereHelperstring{field: "\"audit_logs\".\"variables\""},
+	Outcome:       whereHelperstring{field: "\"audit_logs\".\"outcome\""},
+	DurationMS:    whereHelperint64{field: "\"audit_logs\".\"duration_ms\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"audit_logs\".\"created_at\""},
+}
+
+// AuditLogRels is where relationship names are stored.
+var AuditLogRels = struct {
+	AuditLogNodes string
+}{
+	AuditLogNodes: "AuditLogNodes",
+}
+
+// auditLogR is where relationships are stored.
+type auditLogR struct {
+	AuditLogNodes AuditLogNodeSlice `boil:"AuditLogNodes" json:"AuditLogNodes" toml:"AuditLogNodes" yaml:"AuditLogNodes"`
+}
+
+// NewStruct creates a new relationship struct
+func (*auditLogR) NewStruct() *auditLogR {
+	return &auditLogR{}
+}
+
+func (r *auditLogR) GetAuditLogNodes() AuditLogNodeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AuditLogNodes
+}
+
+// auditLogL is where Load methods for each relationship are stored.
+type auditLogL struct{}
+
+var (
+	auditLogAllColumns            = []string{"id", "actor", "impersonator", "operation_name", "variables", "outcome", "duration_ms", "created_at"}
+	auditLogColumnsWithoutDefault = []string{"id", "outcome", "duration_ms", "created_at"}
+	auditLogColumnsWithDefault    = []string{"actor", "impersonator", "operation_name", "variables"}
+	auditLogPrimaryKeyColumns     = []string{"id"}
+	auditLogGeneratedColumns      = []string{}
+)
+
+type (
+	// AuditLogSlice is an alias for a slice of pointers to AuditLog.
+	// This should almost always be used instead of []AuditLog.
+	AuditLogSlice []*AuditLog
+	// AuditLogHook is the signature for custom AuditLog hook methods
+	AuditLogHook func(context.Context, boil.ContextExecutor, *AuditLog) error
+
+	auditLogQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	auditLogType                 = reflect.TypeOf(&AuditLog{})
+	auditLogMapping              = queries.MakeStructMapping(auditLogType)
+	auditLogPrimaryKeyMapping, _ = queries.BindMapping(auditLogType, auditLogMapping, auditLogPrimaryKeyColumns)
+	auditLogInsertCacheMut       sync.RWMutex
+	auditLogInsertCache          = make(map[string]insertCache)
+	auditLogUpdateCacheMut       sync.RWMutex
+	auditLogUpdateCache          = make(map[string]updateCache)
+	auditLogUpsertCacheMut       sync.RWMutex
+	auditLogUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var auditLogAfterSelectHooks []AuditLogHook
+
+var auditLogBeforeInsertHooks []AuditLogHook
+var auditLogAfterInsertHooks []AuditLogHook
+
+var auditLogBeforeUpdateHooks []AuditLogHook
+var auditLogAfterUpdateHooks []AuditLogHook
+
+var auditLogBeforeDeleteHooks []AuditLogHook
+var auditLogAfterDeleteHooks []AuditLogHook
+
+var auditLogBeforeUpsertHooks []AuditLogHook
+var auditLogAfterUpsertHooks []AuditLogHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AuditLog) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AuditLog) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AuditLog) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AuditLog) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AuditLog) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AuditLog) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AuditLog) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AuditLog) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AuditLog) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAuditLogHook registers your hook function for all future operations.
+func AddAuditLogHook(hookPoint boil.HookPoint, auditLogHook AuditLogHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		auditLogAfterSelectHooks = append(auditLogAfterSelectHooks, auditLogHook)
+	case boil.BeforeInsertHook:
+		auditLogBeforeInsertHooks = append(auditLogBeforeInsertHooks, auditLogHook)
+	case boil.AfterInsertHook:
+		auditLogAfterInsertHooks = append(auditLogAfterInsertHooks, auditLogHook)
+	case boil.BeforeUpdateHook:
+		auditLogBeforeUpdateHooks = append(auditLogBeforeUpdateHooks, auditLogHook)
+	case boil.AfterUpdateHook:
+		auditLogAfterUpdateHooks = append(auditLogAfterUpdateHooks, auditLogHook)
+	case boil.BeforeDeleteHook:
+		auditLogBeforeDeleteHooks = append(auditLogBeforeDeleteHooks, auditLogHook)
+	case boil.AfterDeleteHook:
+		auditLogAfterDeleteHooks = append(auditLogAfterDeleteHooks, auditLogHook)
+	case boil.BeforeUpsertHook:
+		auditLogBeforeUpsertHooks = append(auditLogBeforeUpsertHooks, auditLogHook)
+	case boil.AfterUpsertHook:
+		auditLogAfterUpsertHooks = append(auditLogAfterUpsertHooks, auditLogHook)
+	}
+}
+
+// One returns a single auditLog record from the query.
+func (q auditLogQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AuditLog, error) {
+	o := &AuditLog{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for audit_logs")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AuditLog records from the query.
+func (q auditLogQuery) All(ctx context.Context, exec boil.ContextExecutor) (AuditLogSlice, error) {
+	var o []*AuditLog
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to AuditLog slice")
+	}
+
+	if len(auditLogAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AuditLog records in the query.
+func (q auditLogQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count audit_logs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q auditLogQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if audit_logs exists")
+	}
+
+	return count > 0, nil
+}
+
+// AuditLogNodes retrieves all the audit_log_node's AuditLogNodes with an executor.
+func (o *AuditLog) AuditLogNodes(mods ...qm.QueryMod) auditLogNodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"audit_log_nodes\".\"audit_log\"=?", o.ID),
+	)
+
+	return AuditLogNodes(queryMods...)
+}
+
+// LoadAuditLogNodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (auditLogL) LoadAuditLogNodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuditLog interface{}, mods queries.Applicator) error {
+	var slice []*AuditLog
+	var object *AuditLog
+
+	if singular {
+		var ok bool
+		object, ok = maybeAuditLog.(*AuditLog)
+		if !ok {
+			object = new(AuditLog)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuditLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuditLog))
+			}
+		}
+	} else {
+		s, ok := maybeAuditLog.(*[]*AuditLog)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuditLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuditLog))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &auditLogR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &auditLogR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`audit_log_nodes`),
+		qm.WhereIn(`audit_log_nodes.audit_log in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load audit_log_nodes")
+	}
+
+	var resultSlice []*AuditLogNode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_log_nodes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on audit_log_nodes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_log_nodes")
+	}
+
+	if len(auditLogNodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AuditLogNodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &auditLogNodeR{}
+			}
+			foreign.R.AuditLogNodeAuditLog = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AuditLog {
+				local.R.AuditLogNodes = append(local.R.AuditLogNodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &auditLogNodeR{}
+				}
+				foreign.R.AuditLogNodeAuditLog = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAuditLogNodes adds the given related objects to the existing relationships
+// of the audit_log, optionally inserting them as new records.
+// Appends related to o.R.AuditLogNodes.
+// Sets related.R.AuditLogNodeAuditLog appropriately.
+func (o *AuditLog) AddAuditLogNodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLogNode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AuditLog = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_log_nodes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"audit_log"}),
+				strmangle.WhereClause("\"", "\"", 0, auditLogNodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.AuditLog, rel.NodeID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AuditLog = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &auditLogR{
+			AuditLogNodes: related,
+		}
+	} else {
+		o.R.AuditLogNodes = append(o.R.AuditLogNodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditLogNodeR{
+				AuditLogNodeAuditLog: o,
+			}
+		} else {
+			rel.R.AuditLogNodeAuditLog = o
+		}
+	}
+	return nil
+}
+
+// AuditLogs retrieves all the records using an executor.
+func AuditLogs(mods ...qm.QueryMod) auditLogQuery {
+	mods = append(mods, qm.From("\"audit_logs\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"audit_logs\".*"})
+	}
+
+	return auditLogQuery{q}
+}
+
+// FindAuditLog retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAuditLog(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*AuditLog, error) {
+	auditLogObj := &AuditLog{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"audit_logs\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, auditLogObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from audit_logs")
+	}
+
+	if err = auditLogObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return auditLogObj, err
+	}
+
+	return auditLogObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AuditLog) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no audit_logs provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	auditLogInsertCacheMut.RLock()
+	cache, cached := auditLogInsertCache[key]
+	auditLogInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			auditLogAllColumns,
+			auditLogColumnsWithDefault,
+			auditLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogType, auditLogMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(auditLogType, auditLogMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"audit_logs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"audit_logs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into audit_logs")
+	}
+
+	if !cached {
+		auditLogInsertCacheMut.Lock()
+		auditLogInsertCache[key] = cache
+		auditLogInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AuditLog.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AuditLog) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	auditLogUpdateCacheMut.RLock()
+	cache, cached := auditLogUpdateCache[key]
+	auditLogUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			auditLogAllColumns,
+			auditLogPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update audit_logs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"audit_logs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, auditLogPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(auditLogType, auditLogMapping, append(wl, auditLogPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update audit_logs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for audit_logs")
+	}
+
+	if !cached {
+		auditLogUpdateCacheMut.Lock()
+		auditLogUpdateCache[key] = cache
+		auditLogUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q auditLogQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for audit_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for audit_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AuditLogSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"audit_logs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in auditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all auditLog")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AuditLog) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no audit_logs provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	auditLogUpsertCacheMut.RLock()
+	cache, cached := auditLogUpsertCache[key]
+	auditLogUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			auditLogAllColumns,
+			auditLogColumnsWithDefault,
+			auditLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			auditLogAllColumns,
+			auditLogPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("db: unable to upsert audit_logs, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(auditLogPrimaryKeyColumns))
+			copy(conflict, auditLogPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"audit_logs\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogType, auditLogMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(auditLogType, auditLogMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "db: unable to upsert audit_logs")
+	}
+
+	if !cached {
+		auditLogUpsertCacheMut.Lock()
+		auditLogUpsertCache[key] = cache
+		auditLogUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AuditLog record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AuditLog) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no AuditLog provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), auditLogPrimaryKeyMapping)
+	sql := "DELETE FROM \"audit_logs\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from audit_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for audit_logs")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q auditLogQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no auditLogQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from audit_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for audit_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AuditLogSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(auditLogBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"audit_logs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from auditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for audit_logs")
+	}
+
+	if len(auditLogAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AuditLog) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAuditLog(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuditLogSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AuditLogSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"audit_logs\".* FROM \"audit_logs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in AuditLogSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AuditLogExists checks if the AuditLog row exists.
+func AuditLogExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"audit_logs\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if audit_logs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AuditLog row exists.
+func (o *AuditLog) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AuditLogExists(ctx, exec, o.ID)
+}
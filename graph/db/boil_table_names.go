@@ -4,17 +4,37 @@
 package db
 
 var TableNames = struct {
-	Issues       string
-	Projectcards string
-	Projects     string
-	Pullrequests string
-	Repositories string
-	Users        string
+	AuditLogNodes               string
+	AuditLogs                   string
+	Collaborators               string
+	Impersonations              string
+	Issues                      string
+	PersistedQueries            string
+	PersonalAccessTokens        string
+	Projectcards                string
+	Projects                    string
+	Pullrequests                string
+	Repositories                string
+	RepositoryInteractionLimits string
+	RepositoryInvitations       string
+	Sessions                    string
+	UserBlocks                  string
+	Users                       string
 }{
-	Issues:       "issues",
-	Projectcards: "projectcards",
-	Projects:     "projects",
-	Pullrequests: "pullrequests",
-	Repositories: "repositories",
-	Users:        "users",
+	AuditLogNodes:               "audit_log_nodes",
+	AuditLogs:                   "audit_logs",
+	Collaborators:               "collaborators",
+	Impersonations:              "impersonations",
+	Issues:                      "issues",
+	PersistedQueries:            "persisted_queries",
+	PersonalAccessTokens:        "personal_access_tokens",
+	Projectcards:                "projectcards",
+	Projects:                    "projects",
+	Pullrequests:                "pullrequests",
+	Repositories:                "repositories",
+	RepositoryInteractionLimits: "repository_interaction_limits",
+	RepositoryInvitations:       "repository_invitations",
+	Sessions:                    "sessions",
+	UserBlocks:                  "user_blocks",
+	Users:                       "users",
 }
@@ -0,0 +1,1278 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Collaborator is an object representing the database table.
+type Collaborator struct {
+	Repository   string `boil:"repository" json:"repository" toml:"repository" yaml:"repository"`
+	Collaborator string `boil:"collaborator" json:"collaborator" toml:"collaborator" yaml:"collaborator"`
+	Permission   string `boil:"permission" json:"permission" toml:"permission" yaml:"permission"`
+
+	R *collaboratorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L collaboratorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CollaboratorColumns = struct {
+	Repository   string
+	Collaborator string
+	Permission   string
+}{
+	Repository:   "repository",
+	Collaborator: "collaborator",
+	Permission:   "permission",
+}
+
+var CollaboratorTableColumns = struct {
+	Repository   string
+	Collaborator string
+	Permission   string
+}{
+	Repository:   "collaborators.repository",
+	Collaborator: "collaborators.collaborator",
+	Permission:   "collaborators.permission",
+}
+
+// Generated where
+
+var CollaboratorWhere = struct {
+	Repository   whereHelperstring
+	Collaborator whereHelperstring
+	Permission   whereHelperstring
+}{
+	Repository:   whereHelperstring{field: "\"collaborators\".\"repository\""},
+	Collaborator: whereHelperstring{field: "\"collaborators\".\"collaborator\""},
+	Permission:   whereHelperstring{field: "\"collaborators\".\"permission\""},
+}
+
+// CollaboratorRels is where relationship names are stored.
+var CollaboratorRels = struct {
+	CollaboratorUser       string
+	CollaboratorRepository string
+}{
+	CollaboratorUser:       "CollaboratorUser",
+	CollaboratorRepository: "CollaboratorRepository",
+}
+
+// collaboratorR is where relationships are stored.
+type collaboratorR struct {
+	CollaboratorUser       *User       `boil:"CollaboratorUser" json:"CollaboratorUser" toml:"CollaboratorUser" yaml:"CollaboratorUser"`
+	CollaboratorRepository *Repository `boil:"CollaboratorRepository" json:"CollaboratorRepository" toml:"CollaboratorRepository" yaml:"CollaboratorRepository"`
+}
+
+// NewStruct creates a new relationship struct
+func (*collaboratorR) NewStruct() *collaboratorR {
+	return &collaboratorR{}
+}
+
+func (r *collaboratorR) GetCollaboratorUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.CollaboratorUser
+}
+
+func (r *collaboratorR) GetCollaboratorRepository() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.CollaboratorRepository
+}
+
+// collaboratorL is where Load methods for each relationship are stored.
+type collaboratorL struct{}
+
+var (
+	collaboratorAllColumns            = []string{"repository", "collaborator", "permission"}
+	collaboratorColumnsWithoutDefault = []string{"repository", "collaborator", "permission"}
+	collaboratorColumnsWithDefault    = []string{}
+	collaboratorPrimaryKeyColumns     = []string{"repository", "collaborator"}
+	collaboratorGeneratedColumns      = []string{}
+)
+
+type (
+	// CollaboratorSlice is an alias for a slice of pointers to Collaborator.
+	// This should almost always be used instead of []Collaborator.
+	CollaboratorSlice []*Collaborator
+	// CollaboratorHook is the signature for custom Collaborator hook methods
+	CollaboratorHook func(context.Context, boil.ContextExecutor, *Collaborator) error
+
+	collaboratorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	collaboratorType                 = reflect.TypeOf(&Collaborator{})
+	collaboratorMapping              = queries.MakeStructMapping(collaboratorType)
+	collaboratorPrimaryKeyMapping, _ = queries.BindMapping(collaboratorType, collaboratorMapping, collaboratorPrimaryKeyColumns)
+	collaboratorInsertCacheMut       sync.RWMutex
+	collaboratorInsertCache          = make(map[string]insertCache)
+	collaboratorUpdateCacheMut       sync.RWMutex
+	collaboratorUpdateCache          = make(map[string]updateCache)
+	collaboratorUpsertCacheMut       sync.RWMutex
+	collaboratorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var collaboratorAfterSelectHooks []CollaboratorHook
+
+var collaboratorBeforeInsertHooks []CollaboratorHook
+var collaboratorAfterInsertHooks []CollaboratorHook
+
+var collaboratorBeforeUpdateHooks []CollaboratorHook
+var collaboratorAfterUpdateHooks []CollaboratorHook
+
+var collaboratorBeforeDeleteHooks []CollaboratorHook
+var collaboratorAfterDeleteHooks []CollaboratorHook
+
+var collaboratorBeforeUpsertHooks []CollaboratorHook
+var collaboratorAfterUpsertHooks []CollaboratorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Collaborator) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collaboratorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Collaborator) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collaboratorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Collaborator) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collaboratorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Collaborator) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collaboratorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Collaborator) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collaboratorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Collaborator) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collaboratorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Collaborator) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collaboratorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Collaborator) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collaboratorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Collaborator) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collaboratorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCollaboratorHook registers your hook function for all future operations.
+func AddCollaboratorHook(hookPoint boil.HookPoint, collaboratorHook CollaboratorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		collaboratorAfterSelectHooks = append(collaboratorAfterSelectHooks, collaboratorHook)
+	case boil.BeforeInsertHook:
+		collaboratorBeforeInsertHooks = append(collaboratorBeforeInsertHooks, collaboratorHook)
+	case boil.AfterInsertHook:
+		collaboratorAfterInsertHooks = append(collaboratorAfterInsertHooks, collaboratorHook)
+	case boil.BeforeUpdateHook:
+		collaboratorBeforeUpdateHooks = append(collaboratorBeforeUpdateHooks, collaboratorHook)
+	case boil.AfterUpdateHook:
+		collaboratorAfterUpdateHooks = append(collaboratorAfterUpdateHooks, collaboratorHook)
+	case boil.BeforeDeleteHook:
+		collaboratorBeforeDeleteHooks = append(collaboratorBeforeDeleteHooks, collaboratorHook)
+	case boil.AfterDeleteHook:
+		collaboratorAfterDeleteHooks = append(collaboratorAfterDeleteHooks, collaboratorHook)
+	case boil.BeforeUpsertHook:
+		collaboratorBeforeUpsertHooks = append(collaboratorBeforeUpsertHooks, collaboratorHook)
+	case boil.AfterUpsertHook:
+		collaboratorAfterUpsertHooks = append(collaboratorAfterUpsertHooks, collaboratorHook)
+	}
+}
+
+// One returns a single collaborator record from the query.
+func (q collaboratorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Collaborator, error) {
+	o := &Collaborator{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for collaborators")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Collaborator records from the query.
+func (q collaboratorQuery) All(ctx context.Context, exec boil.ContextExecutor) (CollaboratorSlice, error) {
+	var o []*Collaborator
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to Collaborator slice")
+	}
+
+	if len(collaboratorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Collaborator records in the query.
+func (q collaboratorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count collaborators rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q collaboratorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if collaborators exists")
+	}
+
+	return count > 0, nil
+}
+
+// CollaboratorUser pointed to by the foreign key.
+func (o *Collaborator) CollaboratorUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Collaborator),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// CollaboratorRepository pointed to by the foreign key.
+func (o *Collaborator) CollaboratorRepository(mods ...qm.QueryMod) repositoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Repository),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Repositories(queryMods...)
+}
+
+// LoadCollaboratorUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (collaboratorL) LoadCollaboratorUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollaborator interface{}, mods queries.Applicator) error {
+	var slice []*Collaborator
+	var object *Collaborator
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollaborator.(*Collaborator)
+		if !ok {
+			object = new(Collaborator)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollaborator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollaborator))
+			}
+		}
+	} else {
+		s, ok := maybeCollaborator.(*[]*Collaborator)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollaborator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollaborator))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collaboratorR{}
+		}
+		args = append(args, object.Collaborator)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collaboratorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Collaborator {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Collaborator)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CollaboratorUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CollaboratorCollaborators = append(foreign.R.CollaboratorCollaborators, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Collaborator == foreign.ID {
+				local.R.CollaboratorUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CollaboratorCollaborators = append(foreign.R.CollaboratorCollaborators, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCollaboratorRepository allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (collaboratorL) LoadCollaboratorRepository(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollaborator interface{}, mods queries.Applicator) error {
+	var slice []*Collaborator
+	var object *Collaborator
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollaborator.(*Collaborator)
+		if !ok {
+			object = new(Collaborator)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollaborator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollaborator))
+			}
+		}
+	} else {
+		s, ok := maybeCollaborator.(*[]*Collaborator)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollaborator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollaborator))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collaboratorR{}
+		}
+		args = append(args, object.Repository)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collaboratorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Repository {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Repository)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`repositories`),
+		qm.WhereIn(`repositories.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Repository")
+	}
+
+	var resultSlice []*Repository
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Repository")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for repositories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repositories")
+	}
+
+	if len(repositoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CollaboratorRepository = foreign
+		if foreign.R == nil {
+			foreign.R = &repositoryR{}
+		}
+		foreign.R.Collaborators = append(foreign.R.Collaborators, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Repository == foreign.ID {
+				local.R.CollaboratorRepository = foreign
+				if foreign.R == nil {
+					foreign.R = &repositoryR{}
+				}
+				foreign.R.Collaborators = append(foreign.R.Collaborators, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCollaboratorUser of the collaborator to the related item.
+// Sets o.R.CollaboratorUser to related.
+// Adds o to related.R.CollaboratorCollaborators.
+func (o *Collaborator) SetCollaboratorUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"collaborators\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"collaborator"}),
+		strmangle.WhereClause("\"", "\"", 0, collaboratorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Repository, o.Collaborator}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Collaborator = related.ID
+	if o.R == nil {
+		o.R = &collaboratorR{
+			CollaboratorUser: related,
+		}
+	} else {
+		o.R.CollaboratorUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CollaboratorCollaborators: CollaboratorSlice{o},
+		}
+	} else {
+		related.R.CollaboratorCollaborators = append(related.R.CollaboratorCollaborators, o)
+	}
+
+	return nil
+}
+
+// SetCollaboratorRepository of the collaborator to the related item.
+// Sets o.R.CollaboratorRepository to related.
+// Adds o to related.R.Collaborators.
+func (o *Collaborator) SetCollaboratorRepository(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Repository) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"collaborators\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"repository"}),
+		strmangle.WhereClause("\"", "\"", 0, collaboratorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Repository, o.Collaborator}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Repository = related.ID
+	if o.R == nil {
+		o.R = &collaboratorR{
+			CollaboratorRepository: related,
+		}
+	} else {
+		o.R.CollaboratorRepository = related
+	}
+
+	if related.R == nil {
+		related.R = &repositoryR{
+			Collaborators: CollaboratorSlice{o},
+		}
+	} else {
+		related.R.Collaborators = append(related.R.Collaborators, o)
+	}
+
+	return nil
+}
+
+// Collaborators retrieves all the records using an executor.
+func Collaborators(mods ...qm.QueryMod) collaboratorQuery {
+	mods = append(mods, qm.From("\"collaborators\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"collaborators\".*"})
+	}
+
+	return collaboratorQuery{q}
+}
+
+// FindCollaborator retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCollaborator(ctx context.Context, exec boil.ContextExecutor, repository string, collaborator string, selectCols ...string) (*Collaborator, error) {
+	collaboratorObj := &Collaborator{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"collaborators\" where \"repository\"=? AND \"collaborator\"=?", sel,
+	)
+
+	q := queries.Raw(query, repository, collaborator)
+
+	err := q.Bind(ctx, exec, collaboratorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from collaborators")
+	}
+
+	if err = collaboratorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return collaboratorObj, err
+	}
+
+	return collaboratorObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Collaborator) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no collaborators provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(collaboratorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	collaboratorInsertCacheMut.RLock()
+	cache, cached := collaboratorInsertCache[key]
+	collaboratorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			collaboratorAllColumns,
+			collaboratorColumnsWithDefault,
+			collaboratorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(collaboratorType, collaboratorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(collaboratorType, collaboratorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"collaborators\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"collaborators\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into collaborators")
+	}
+
+	if !cached {
+		collaboratorInsertCacheMut.Lock()
+		collaboratorInsertCache[key] = cache
+		collaboratorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Collaborator.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Collaborator) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	collaboratorUpdateCacheMut.RLock()
+	cache, cached := collaboratorUpdateCache[key]
+	collaboratorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			collaboratorAllColumns,
+			collaboratorPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update collaborators, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"collaborators\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, collaboratorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(collaboratorType, collaboratorMapping, append(wl, collaboratorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update collaborators row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for collaborators")
+	}
+
+	if !cached {
+		collaboratorUpdateCacheMut.Lock()
+		collaboratorUpdateCache[key] = cache
+		collaboratorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q collaboratorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for collaborators")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for collaborators")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CollaboratorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collaboratorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"collaborators\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, collaboratorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in collaborator slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all collaborator")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Collaborator) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no collaborators provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(collaboratorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	collaboratorUpsertCacheMut.RLock()
+	cache, cached := collaboratorUpsertCache[key]
+	collaboratorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			collaboratorAllColumns,
+			collaboratorColumnsWithDefault,
+			collaboratorColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			collaboratorAllColumns,
+			collaboratorPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("db: unable to upsert collaborators, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(collaboratorPrimaryKeyColumns))
+			copy(conflict, collaboratorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"collaborators\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(collaboratorType, collaboratorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(collaboratorType, collaboratorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "db: unable to upsert collaborators")
+	}
+
+	if !cached {
+		collaboratorUpsertCacheMut.Lock()
+		collaboratorUpsertCache[key] = cache
+		collaboratorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Collaborator record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Collaborator) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no Collaborator provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), collaboratorPrimaryKeyMapping)
+	sql := "DELETE FROM \"collaborators\" WHERE \"repository\"=? AND \"collaborator\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from collaborators")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for collaborators")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q collaboratorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no collaboratorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from collaborators")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for collaborators")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CollaboratorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(collaboratorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collaboratorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"collaborators\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, collaboratorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from collaborator slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for collaborators")
+	}
+
+	if len(collaboratorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Collaborator) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCollaborator(ctx, exec, o.Repository, o.Collaborator)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CollaboratorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CollaboratorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collaboratorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"collaborators\".* FROM \"collaborators\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, collaboratorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in CollaboratorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CollaboratorExists checks if the Collaborator row exists.
+func CollaboratorExists(ctx context.Context, exec boil.ContextExecutor, repository string, collaborator string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"collaborators\" where \"repository\"=? AND \"collaborator\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, repository, collaborator)
+	}
+	row := exec.QueryRowContext(ctx, sql, repository, collaborator)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if collaborators exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Collaborator row exists.
+func (o *Collaborator) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CollaboratorExists(ctx, exec, o.Repository, o.Collaborator)
+}
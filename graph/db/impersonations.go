@@ -0,0 +1,1306 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Impersonation is an object representing the database table.
+type Impersonation struct {
+	ID            string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Admin         string    `boil:"admin" json:"admin" toml:"admin" yaml:"admin"`
+	Target        string    `boil:"target" json:"target" toml:"target" yaml:"target"`
+	OperationName string    `boil:"operation_name" json:"operation_name" toml:"operation_name" yaml:"operation_name"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *impersonationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L impersonationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ImpersonationColumns = struct {
+	ID            string
+	Admin         string
+	Target        string
+	OperationName string
+	CreatedAt     string
+}{
+	ID:            "id",
+	Admin:         "admin",
+	Target:        "target",
+	OperationName: "operation_name",
+	CreatedAt:     "created_at",
+}
+
+var ImpersonationTableColumns = struct {
+	ID            string
+	Admin         string
+	Target        string
+	OperationName string
+	CreatedAt     string
+}{
+	ID:            "impersonations.id",
+	Admin:         "impersonations.admin",
+	Target:        "impersonations.target",
+	OperationName: "impersonations.operation_name",
+	CreatedAt:     "impersonations.created_at",
+}
+
+// Generated where
+
+var ImpersonationWhere = struct {
+	ID            whereHelperstring
+	Admin         whereHelperstring
+	Target        whereHelperstring
+	OperationName whereHelperstring
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"impersonations\".\"id\""},
+	Admin:         whereHelperstring{field: "\"impersonations\".\"admin\""},
+	Target:        whereHelperstring{field: "\"impersonations\".\"target\""},
+	OperationName: whereHelperstring{field: "\"impersonations\".\"operation_name\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"impersonations\".\"created_at\""},
+}
+
+// ImpersonationRels is where relationship names are stored.
+var ImpersonationRels = struct {
+	TargetUser string
+	AdminUser  string
+}{
+	TargetUser: "TargetUser",
+	AdminUser:  "AdminUser",
+}
+
+// impersonationR is where relationships are stored.
+type impersonationR struct {
+	TargetUser *User `boil:"TargetUser" json:"TargetUser" toml:"TargetUser" yaml:"TargetUser"`
+	AdminUser  *User `boil:"AdminUser" json:"AdminUser" toml:"AdminUser" yaml:"AdminUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*impersonationR) NewStruct() *impersonationR {
+	return &impersonationR{}
+}
+
+func (r *impersonationR) GetTargetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.TargetUser
+}
+
+func (r *impersonationR) GetAdminUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.AdminUser
+}
+
+// impersonationL is where Load methods for each relationship are stored.
+type impersonationL struct{}
+
+var (
+	impersonationAllColumns            = []string{"id", "admin", "target", "operation_name", "created_at"}
+	impersonationColumnsWithoutDefault = []string{"id", "admin", "target", "created_at"}
+	impersonationColumnsWithDefault    = []string{"operation_name"}
+	impersonationPrimaryKeyColumns     = []string{"id"}
+	impersonationGeneratedColumns      = []string{}
+)
+
+type (
+	// ImpersonationSlice is an alias for a slice of pointers to Impersonation.
+	// This should almost always be used instead of []Impersonation.
+	ImpersonationSlice []*Impersonation
+	// ImpersonationHook is the signature for custom Impersonation hook methods
+	ImpersonationHook func(context.Context, boil.ContextExecutor, *Impersonation) error
+
+	impersonationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	impersonationType                 = reflect.TypeOf(&Impersonation{})
+	impersonationMapping              = queries.MakeStructMapping(impersonationType)
+	impersonationPrimaryKeyMapping, _ = queries.BindMapping(impersonationType, impersonationMapping, impersonationPrimaryKeyColumns)
+	impersonationInsertCacheMut       sync.RWMutex
+	impersonationInsertCache          = make(map[string]insertCache)
+	impersonationUpdateCacheMut       sync.RWMutex
+	impersonationUpdateCache          = make(map[string]updateCache)
+	impersonationUpsertCacheMut       sync.RWMutex
+	impersonationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var impersonationAfterSelectHooks []ImpersonationHook
+
+var impersonationBeforeInsertHooks []ImpersonationHook
+var impersonationAfterInsertHooks []ImpersonationHook
+
+var impersonationBeforeUpdateHooks []ImpersonationHook
+var impersonationAfterUpdateHooks []ImpersonationHook
+
+var impersonationBeforeDeleteHooks []ImpersonationHook
+var impersonationAfterDeleteHooks []ImpersonationHook
+
+var impersonationBeforeUpsertHooks []ImpersonationHook
+var impersonationAfterUpsertHooks []ImpersonationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Impersonation) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range impersonationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Impersonation) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range impersonationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Impersonation) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range impersonationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Impersonation) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range impersonationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Impersonation) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range impersonationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Impersonation) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range impersonationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Impersonation) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range impersonationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Impersonation) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range impersonationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Impersonation) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range impersonationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddImpersonationHook registers your hook function for all future operations.
+func AddImpersonationHook(hookPoint boil.HookPoint, impersonationHook ImpersonationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		impersonationAfterSelectHooks = append(impersonationAfterSelectHooks, impersonationHook)
+	case boil.BeforeInsertHook:
+		impersonationBeforeInsertHooks = append(impersonationBeforeInsertHooks, impersonationHook)
+	case boil.AfterInsertHook:
+		impersonationAfterInsertHooks = append(impersonationAfterInsertHooks, impersonationHook)
+	case boil.BeforeUpdateHook:
+		impersonationBeforeUpdateHooks = append(impersonationBeforeUpdateHooks, impersonationHook)
+	case boil.AfterUpdateHook:
+		impersonationAfterUpdateHooks = append(impersonationAfterUpdateHooks, impersonationHook)
+	case boil.BeforeDeleteHook:
+		impersonationBeforeDeleteHooks = append(impersonationBeforeDeleteHooks, impersonationHook)
+	case boil.AfterDeleteHook:
+		impersonationAfterDeleteHooks = append(impersonationAfterDeleteHooks, impersonationHook)
+	case boil.BeforeUpsertHook:
+		impersonationBeforeUpsertHooks = append(impersonationBeforeUpsertHooks, impersonationHook)
+	case boil.AfterUpsertHook:
+		impersonationAfterUpsertHooks = append(impersonationAfterUpsertHooks, impersonationHook)
+	}
+}
+
+// One returns a single impersonation record from the query.
+func (q impersonationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Impersonation, error) {
+	o := &Impersonation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for impersonations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Impersonation records from the query.
+func (q impersonationQuery) All(ctx context.Context, exec boil.ContextExecutor) (ImpersonationSlice, error) {
+	var o []*Impersonation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to Impersonation slice")
+	}
+
+	if len(impersonationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Impersonation records in the query.
+func (q impersonationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count impersonations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q impersonationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if impersonations exists")
+	}
+
+	return count > 0, nil
+}
+
+// TargetUser pointed to by the foreign key.
+func (o *Impersonation) TargetUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Target),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// AdminUser pointed to by the foreign key.
+func (o *Impersonation) AdminUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Admin),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadTargetUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (impersonationL) LoadTargetUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeImpersonation interface{}, mods queries.Applicator) error {
+	var slice []*Impersonation
+	var object *Impersonation
+
+	if singular {
+		var ok bool
+		object, ok = maybeImpersonation.(*Impersonation)
+		if !ok {
+			object = new(Impersonation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImpersonation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImpersonation))
+			}
+		}
+	} else {
+		s, ok := maybeImpersonation.(*[]*Impersonation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImpersonation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImpersonation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &impersonationR{}
+		}
+		args = append(args, object.Target)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &impersonationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Target {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Target)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TargetUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TargetImpersonations = append(foreign.R.TargetImpersonations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Target == foreign.ID {
+				local.R.TargetUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TargetImpersonations = append(foreign.R.TargetImpersonations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAdminUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (impersonationL) LoadAdminUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeImpersonation interface{}, mods queries.Applicator) error {
+	var slice []*Impersonation
+	var object *Impersonation
+
+	if singular {
+		var ok bool
+		object, ok = maybeImpersonation.(*Impersonation)
+		if !ok {
+			object = new(Impersonation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImpersonation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImpersonation))
+			}
+		}
+	} else {
+		s, ok := maybeImpersonation.(*[]*Impersonation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImpersonation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImpersonation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &impersonationR{}
+		}
+		args = append(args, object.Admin)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &impersonationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Admin {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Admin)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AdminUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AdminImpersonations = append(foreign.R.AdminImpersonations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Admin == foreign.ID {
+				local.R.AdminUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AdminImpersonations = append(foreign.R.AdminImpersonations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTargetUser of the impersonation to the related item.
+// Sets o.R.TargetUser to related.
+// Adds o to related.R.TargetImpersonations.
+func (o *Impersonation) SetTargetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"impersonations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"target"}),
+		strmangle.WhereClause("\"", "\"", 0, impersonationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Target = related.ID
+	if o.R == nil {
+		o.R = &impersonationR{
+			TargetUser: related,
+		}
+	} else {
+		o.R.TargetUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TargetImpersonations: ImpersonationSlice{o},
+		}
+	} else {
+		related.R.TargetImpersonations = append(related.R.TargetImpersonations, o)
+	}
+
+	return nil
+}
+
+// SetAdminUser of the impersonation to the related item.
+// Sets o.R.AdminUser to related.
+// Adds o to related.R.AdminImpersonations.
+func (o *Impersonation) SetAdminUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"impersonations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"admin"}),
+		strmangle.WhereClause("\"", "\"", 0, impersonationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Admin = related.ID
+	if o.R == nil {
+		o.R = &impersonationR{
+			AdminUser: related,
+		}
+	} else {
+		o.R.AdminUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AdminImpersonations: ImpersonationSlice{o},
+		}
+	} else {
+		related.R.AdminImpersonations = append(related.R.AdminImpersonations, o)
+	}
+
+	return nil
+}
+
+// Impersonations retrieves all the records using an executor.
+func Impersonations(mods ...qm.QueryMod) impersonationQuery {
+	mods = append(mods, qm.From("\"impersonations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"impersonations\".*"})
+	}
+
+	return impersonationQuery{q}
+}
+
+// FindImpersonation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindImpersonation(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Impersonation, error) {
+	impersonationObj := &Impersonation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"impersonations\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, impersonationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from impersonations")
+	}
+
+	if err = impersonationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return impersonationObj, err
+	}
+
+	return impersonationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Impersonation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no impersonations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(impersonationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	impersonationInsertCacheMut.RLock()
+	cache, cached := impersonationInsertCache[key]
+	impersonationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			impersonationAllColumns,
+			impersonationColumnsWithDefault,
+			impersonationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(impersonationType, impersonationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(impersonationType, impersonationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"impersonations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"impersonations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into impersonations")
+	}
+
+	if !cached {
+		impersonationInsertCacheMut.Lock()
+		impersonationInsertCache[key] = cache
+		impersonationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Impersonation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Impersonation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	impersonationUpdateCacheMut.RLock()
+	cache, cached := impersonationUpdateCache[key]
+	impersonationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			impersonationAllColumns,
+			impersonationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update impersonations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"impersonations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, impersonationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(impersonationType, impersonationMapping, append(wl, impersonationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update impersonations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for impersonations")
+	}
+
+	if !cached {
+		impersonationUpdateCacheMut.Lock()
+		impersonationUpdateCache[key] = cache
+		impersonationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q impersonationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for impersonations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for impersonations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ImpersonationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), impersonationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"impersonations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, impersonationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in impersonation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all impersonation")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Impersonation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no impersonations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(impersonationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	impersonationUpsertCacheMut.RLock()
+	cache, cached := impersonationUpsertCache[key]
+	impersonationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			impersonationAllColumns,
+			impersonationColumnsWithDefault,
+			impersonationColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			impersonationAllColumns,
+			impersonationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("db: unable to upsert impersonations, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(impersonationPrimaryKeyColumns))
+			copy(conflict, impersonationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"impersonations\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(impersonationType, impersonationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(impersonationType, impersonationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "db: unable to upsert impersonations")
+	}
+
+	if !cached {
+		impersonationUpsertCacheMut.Lock()
+		impersonationUpsertCache[key] = cache
+		impersonationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Impersonation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Impersonation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no Impersonation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), impersonationPrimaryKeyMapping)
+	sql := "DELETE FROM \"impersonations\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from impersonations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for impersonations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q impersonationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no impersonationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from impersonations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for impersonations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ImpersonationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(impersonationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), impersonationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"impersonations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, impersonationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from impersonation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for impersonations")
+	}
+
+	if len(impersonationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Impersonation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindImpersonation(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ImpersonationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ImpersonationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), impersonationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"impersonations\".* FROM \"impersonations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, impersonationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in ImpersonationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ImpersonationExists checks if the Impersonation row exists.
+func ImpersonationExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"impersonations\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if impersonations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Impersonation row exists.
+func (o *Impersonation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ImpersonationExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,929 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PersistedQuery is an object representing the database table.
+type PersistedQuery struct {
+	Hash       string    `boil:"hash" json:"hash" toml:"hash" yaml:"hash"`
+	Query      string    `boil:"query" json:"query" toml:"query" yaml:"query"`
+	UseCount   int64     `boil:"use_count" json:"use_count" toml:"use_count" yaml:"use_count"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	LastUsedAt time.Time `boil:"last_used_at" json:"last_used_at" toml:"last_used_at" yaml:"last_used_at"`
+
+	R *persistedQueryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L persistedQueryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PersistedQueryColumns = struct {
+	Hash       string
+	Query      string
+	UseCount   string
+	CreatedAt  string
+	LastUsedAt string
+}{
+	Hash:       "hash",
+	Query:      "query",
+	UseCount:   "use_count",
+	CreatedAt:  "created_at",
+	LastUsedAt: "last_used_at",
+}
+
+var PersistedQueryTableColumns = struct {
+	Hash       string
+	Query      string
+	UseCount   string
+	CreatedAt  string
+	LastUsedAt string
+}{
+	Hash:       "persisted_queries.hash",
+	Query:      "persisted_queries.query",
+	UseCount:   "persisted_queries.use_count",
+	CreatedAt:  "persisted_queries.created_at",
+	LastUsedAt: "persisted_queries.last_used_at",
+}
+
+// Generated where
+
+var PersistedQueryWhere = struct {
+	Hash       whereHelperstring
+	Query      whereHelperstring
+	UseCount   whereHelperint64
+	CreatedAt  whereHelpertime_Time
+	LastUsedAt whereHelpertime_Time
+}{
+	Hash:       whereHelperstring{field: "\"persisted_queries\".\"hash\""},
+	Query:      whereHelperstring{field: "\"persisted_queries\".\"query\""},
+	UseCount:   whereHelperint64{field: "\"persisted_queries\".\"use_count\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"persisted_queries\".\"created_at\""},
+	LastUsedAt: whereHelpertime_Time{field: "\"persisted_queries\".\"last_used_at\""},
+}
+
+// PersistedQueryRels is where relationship names are stored.
+var PersistedQueryRels = struct {
+}{}
+
+// persistedQueryR is where relationships are stored.
+type persistedQueryR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*persistedQueryR) NewStruct() *persistedQueryR {
+	return &persistedQueryR{}
+}
+
+// persistedQueryL is where Load methods for each relationship are stored.
+type persistedQueryL struct{}
+
+var (
+	persistedQueryAllColumns            = []string{"hash", "query", "use_count", "created_at", "last_used_at"}
+	persistedQueryColumnsWithoutDefault = []string{"hash", "query"}
+	persistedQueryColumnsWithDefault    = []string{"use_count", "created_at", "last_used_at"}
+	persistedQueryPrimaryKeyColumns     = []string{"hash"}
+	persistedQueryGeneratedColumns      = []string{}
+)
+
+type (
+	// PersistedQuerySlice is an alias for a slice of pointers to PersistedQuery.
+	// This should almost always be used instead of []PersistedQuery.
+	PersistedQuerySlice []*PersistedQuery
+	// PersistedQueryHook is the signature for custom PersistedQuery hook methods
+	PersistedQueryHook func(context.Context, boil.ContextExecutor, *PersistedQuery) error
+
+	persistedQueryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	persistedQueryType                 = reflect.TypeOf(&PersistedQuery{})
+	persistedQueryMapping              = queries.MakeStructMapping(persistedQueryType)
+	persistedQueryPrimaryKeyMapping, _ = queries.BindMapping(persistedQueryType, persistedQueryMapping, persistedQueryPrimaryKeyColumns)
+	persistedQueryInsertCacheMut       sync.RWMutex
+	persistedQueryInsertCache          = make(map[string]insertCache)
+	persistedQueryUpdateCacheMut       sync.RWMutex
+	persistedQueryUpdateCache          = make(map[string]updateCache)
+	persistedQueryUpsertCacheMut       sync.RWMutex
+	persistedQueryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var persistedQueryAfterSelectHooks []PersistedQueryHook
+
+var persistedQueryBeforeInsertHooks []PersistedQueryHook
+var persistedQueryAfterInsertHooks []PersistedQueryHook
+
+var persistedQueryBeforeUpdateHooks []PersistedQueryHook
+var persistedQueryAfterUpdateHooks []PersistedQueryHook
+
+var persistedQueryBeforeDeleteHooks []PersistedQueryHook
+var persistedQueryAfterDeleteHooks []PersistedQueryHook
+
+var persistedQueryBeforeUpsertHooks []PersistedQueryHook
+var persistedQueryAfterUpsertHooks []PersistedQueryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PersistedQuery) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range persistedQueryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PersistedQuery) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range persistedQueryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PersistedQuery) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range persistedQueryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PersistedQuery) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range persistedQueryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PersistedQuery) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range persistedQueryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PersistedQuery) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range persistedQueryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PersistedQuery) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range persistedQueryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PersistedQuery) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range persistedQueryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PersistedQuery) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range persistedQueryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPersistedQueryHook registers your hook function for all future operations.
+func AddPersistedQueryHook(hookPoint boil.HookPoint, persistedQueryHook PersistedQueryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		persistedQueryAfterSelectHooks = append(persistedQueryAfterSelectHooks, persistedQueryHook)
+	case boil.BeforeInsertHook:
+		persistedQueryBeforeInsertHooks = append(persistedQueryBeforeInsertHooks, persistedQueryHook)
+	case boil.AfterInsertHook:
+		persistedQueryAfterInsertHooks = append(persistedQueryAfterInsertHooks, persistedQueryHook)
+	case boil.BeforeUpdateHook:
+		persistedQueryBeforeUpdateHooks = append(persistedQueryBeforeUpdateHooks, persistedQueryHook)
+	case boil.AfterUpdateHook:
+		persistedQueryAfterUpdateHooks = append(persistedQueryAfterUpdateHooks, persistedQueryHook)
+	case boil.BeforeDeleteHook:
+		persistedQueryBeforeDeleteHooks = append(persistedQueryBeforeDeleteHooks, persistedQueryHook)
+	case boil.AfterDeleteHook:
+		persistedQueryAfterDeleteHooks = append(persistedQueryAfterDeleteHooks, persistedQueryHook)
+	case boil.BeforeUpsertHook:
+		persistedQueryBeforeUpsertHooks = append(persistedQueryBeforeUpsertHooks, persistedQueryHook)
+	case boil.AfterUpsertHook:
+		persistedQueryAfterUpsertHooks = append(persistedQueryAfterUpsertHooks, persistedQueryHook)
+	}
+}
+
+// One returns a single persistedQuery record from the query.
+func (q persistedQueryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PersistedQuery, error) {
+	o := &PersistedQuery{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for persisted_queries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PersistedQuery records from the query.
+func (q persistedQueryQuery) All(ctx context.Context, exec boil.ContextExecutor) (PersistedQuerySlice, error) {
+	var o []*PersistedQuery
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to PersistedQuery slice")
+	}
+
+	if len(persistedQueryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PersistedQuery records in the query.
+func (q persistedQueryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count persisted_queries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q persistedQueryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if persisted_queries exists")
+	}
+
+	return count > 0, nil
+}
+
+// PersistedQueries retrieves all the records using an executor.
+func PersistedQueries(mods ...qm.QueryMod) persistedQueryQuery {
+	mods = append(mods, qm.From("\"persisted_queries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"persisted_queries\".*"})
+	}
+
+	return persistedQueryQuery{q}
+}
+
+// FindPersistedQuery retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPersistedQuery(ctx context.Context, exec boil.ContextExecutor, hash string, selectCols ...string) (*PersistedQuery, error) {
+	persistedQueryObj := &PersistedQuery{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"persisted_queries\" where \"hash\"=?", sel,
+	)
+
+	q := queries.Raw(query, hash)
+
+	err := q.Bind(ctx, exec, persistedQueryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from persisted_queries")
+	}
+
+	if err = persistedQueryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return persistedQueryObj, err
+	}
+
+	return persistedQueryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PersistedQuery) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no persisted_queries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(persistedQueryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	persistedQueryInsertCacheMut.RLock()
+	cache, cached := persistedQueryInsertCache[key]
+	persistedQueryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			persistedQueryAllColumns,
+			persistedQueryColumnsWithDefault,
+			persistedQueryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(persistedQueryType, persistedQueryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(persistedQueryType, persistedQueryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"persisted_queries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"persisted_queries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into persisted_queries")
+	}
+
+	if !cached {
+		persistedQueryInsertCacheMut.Lock()
+		persistedQueryInsertCache[key] = cache
+		persistedQueryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PersistedQuery.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PersistedQuery) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	persistedQueryUpdateCacheMut.RLock()
+	cache, cached := persistedQueryUpdateCache[key]
+	persistedQueryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			persistedQueryAllColumns,
+			persistedQueryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update persisted_queries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"persisted_queries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, persistedQueryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(persistedQueryType, persistedQueryMapping, append(wl, persistedQueryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update persisted_queries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for persisted_queries")
+	}
+
+	if !cached {
+		persistedQueryUpdateCacheMut.Lock()
+		persistedQueryUpdateCache[key] = cache
+		persistedQueryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q persistedQueryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for persisted_queries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for persisted_queries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PersistedQuerySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), persistedQueryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"persisted_queries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, persistedQueryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in persistedQuery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all persistedQuery")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PersistedQuery) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no persisted_queries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(persistedQueryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	persistedQueryUpsertCacheMut.RLock()
+	cache, cached := persistedQueryUpsertCache[key]
+	persistedQueryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			persistedQueryAllColumns,
+			persistedQueryColumnsWithDefault,
+			persistedQueryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			persistedQueryAllColumns,
+			persistedQueryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("db: unable to upsert persisted_queries, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(persistedQueryPrimaryKeyColumns))
+			copy(conflict, persistedQueryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"persisted_queries\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(persistedQueryType, persistedQueryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(persistedQueryType, persistedQueryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "db: unable to upsert persisted_queries")
+	}
+
+	if !cached {
+		persistedQueryUpsertCacheMut.Lock()
+		persistedQueryUpsertCache[key] = cache
+		persistedQueryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PersistedQuery record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PersistedQuery) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no PersistedQuery provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), persistedQueryPrimaryKeyMapping)
+	sql := "DELETE FROM \"persisted_queries\" WHERE \"hash\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from persisted_queries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for persisted_queries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q persistedQueryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no persistedQueryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from persisted_queries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for persisted_queries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PersistedQuerySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(persistedQueryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), persistedQueryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"persisted_queries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, persistedQueryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from persistedQuery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for persisted_queries")
+	}
+
+	if len(persistedQueryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PersistedQuery) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPersistedQuery(ctx, exec, o.Hash)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PersistedQuerySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PersistedQuerySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), persistedQueryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"persisted_queries\".* FROM \"persisted_queries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, persistedQueryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in PersistedQuerySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PersistedQueryExists checks if the PersistedQuery row exists.
+func PersistedQueryExists(ctx context.Context, exec boil.ContextExecutor, hash string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"persisted_queries\" where \"hash\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, hash)
+	}
+	row := exec.QueryRowContext(ctx, sql, hash)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if persisted_queries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PersistedQuery row exists.
+func (o *PersistedQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PersistedQueryExists(ctx, exec, o.Hash)
+}
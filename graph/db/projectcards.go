@@ -28,6 +28,7 @@ type Projectcard struct {
 	Project     string      `boil:"project" json:"project" toml:"project" yaml:"project"`
 	Issue       null.String `boil:"issue" json:"issue,omitempty" toml:"issue" yaml:"issue,omitempty"`
 	Pullrequest null.String `boil:"pullrequest" json:"pullrequest,omitempty" toml:"pullrequest" yaml:"pullrequest,omitempty"`
+	Archived    int64       `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
 
 	R *projectcardR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L projectcardL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -38,11 +39,13 @@ var ProjectcardColumns = struct {
 	Project     string
 	Issue       string
 	Pullrequest string
+	Archived    string
 }{
 	ID:          "id",
 	Project:     "project",
 	Issue:       "issue",
 	Pullrequest: "pullrequest",
+	Archived:    "archived",
 }
 
 var ProjectcardTableColumns = struct {
@@ -50,63 +53,29 @@ var ProjectcardTableColumns = struct {
 	Project     string
 	Issue       string
 	Pullrequest string
+	Archived    string
 }{
 	ID:          "projectcards.id",
 	Project:     "projectcards.project",
 	Issue:       "projectcards.issue",
 	Pullrequest: "projectcards.pullrequest",
+	Archived:    "projectcards.archived",
 }
 
 // Generated where
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var ProjectcardWhere = struct {
 	ID          whereHelperstring
 	Project     whereHelperstring
 	Issue       whereHelpernull_String
 	Pullrequest whereHelpernull_String
+	Archived    whereHelperint64
 }{
 	ID:          whereHelperstring{field: "\"projectcards\".\"id\""},
 	Project:     whereHelperstring{field: "\"projectcards\".\"project\""},
 	Issue:       whereHelpernull_String{field: "\"projectcards\".\"issue\""},
 	Pullrequest: whereHelpernull_String{field: "\"projectcards\".\"pullrequest\""},
+	Archived:    whereHelperint64{field: "\"projectcards\".\"archived\""},
 }
 
 // ProjectcardRels is where relationship names are stored.
@@ -157,9 +126,9 @@ func (r *projectcardR) GetProjectcardProject() *Project {
 type projectcardL struct{}
 
 var (
-	projectcardAllColumns            = []string{"id", "project", "issue", "pullrequest"}
+	projectcardAllColumns            = []string{"id", "project", "issue", "pullrequest", "archived"}
 	projectcardColumnsWithoutDefault = []string{"id", "project"}
-	projectcardColumnsWithDefault    = []string{"issue", "pullrequest"}
+	projectcardColumnsWithDefault    = []string{"issue", "pullrequest", "archived"}
 	projectcardPrimaryKeyColumns     = []string{"id"}
 	projectcardGeneratedColumns      = []string{}
 )
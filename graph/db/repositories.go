@@ -23,90 +23,85 @@ import (
 
 // Repository is an object representing the database table.
 type Repository struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Owner     string    `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID         string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Owner      string    `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
+	Name       string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Visibility string    `boil:"visibility" json:"visibility" toml:"visibility" yaml:"visibility"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 
 	R *repositoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L repositoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var RepositoryColumns = struct {
-	ID        string
-	Owner     string
-	Name      string
-	CreatedAt string
+	ID         string
+	Owner      string
+	Name       string
+	Visibility string
+	CreatedAt  string
 }{
-	ID:        "id",
-	Owner:     "owner",
-	Name:      "name",
-	CreatedAt: "created_at",
+	ID:         "id",
+	Owner:      "owner",
+	Name:       "name",
+	Visibility: "visibility",
+	CreatedAt:  "created_at",
 }
 
 var RepositoryTableColumns = struct {
-	ID        string
-	Owner     string
-	Name      string
-	CreatedAt string
+	ID         string
+	Owner      string
+	Name       string
+	Visibility string
+	CreatedAt  string
 }{
-	ID:        "repositories.id",
-	Owner:     "repositories.owner",
-	Name:      "repositories.name",
-	CreatedAt: "repositories.created_at",
+	ID:         "repositories.id",
+	Owner:      "repositories.owner",
+	Name:       "repositories.name",
+	Visibility: "repositories.visibility",
+	CreatedAt:  "repositories.created_at",
 }
 
 // Generated where
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var RepositoryWhere = struct {
-	ID        whereHelperstring
-	Owner     whereHelperstring
-	Name      whereHelperstring
-	CreatedAt whereHelpertime_Time
+	ID         whereHelperstring
+	Owner      whereHelperstring
+	Name       whereHelperstring
+	Visibility whereHelperstring
+	CreatedAt  whereHelpertime_Time
 }{
-	ID:        whereHelperstring{field: "\"repositories\".\"id\""},
-	Owner:     whereHelperstring{field: "\"repositories\".\"owner\""},
-	Name:      whereHelperstring{field: "\"repositories\".\"name\""},
-	CreatedAt: whereHelpertime_Time{field: "\"repositories\".\"created_at\""},
+	ID:         whereHelperstring{field: "\"repositories\".\"id\""},
+	Owner:      whereHelperstring{field: "\"repositories\".\"owner\""},
+	Name:       whereHelperstring{field: "\"repositories\".\"name\""},
+	Visibility: whereHelperstring{field: "\"repositories\".\"visibility\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"repositories\".\"created_at\""},
 }
 
 // RepositoryRels is where relationship names are stored.
 var RepositoryRels = struct {
-	OwnerUser    string
-	Issues       string
-	Pullrequests string
+	OwnerUser                  string
+	RepositoryInteractionLimit string
+	Collaborators              string
+	Issues                     string
+	Pullrequests               string
+	RepositoryInvitations      string
 }{
-	OwnerUser:    "OwnerUser",
-	Issues:       "Issues",
-	Pullrequests: "Pullrequests",
+	OwnerUser:                  "OwnerUser",
+	RepositoryInteractionLimit: "RepositoryInteractionLimit",
+	Collaborators:              "Collaborators",
+	Issues:                     "Issues",
+	Pullrequests:               "Pullrequests",
+	RepositoryInvitations:      "RepositoryInvitations",
 }
 
 // repositoryR is where relationships are stored.
 type repositoryR struct {
-	OwnerUser    *User            `boil:"OwnerUser" json:"OwnerUser" toml:"OwnerUser" yaml:"OwnerUser"`
-	Issues       IssueSlice       `boil:"Issues" json:"Issues" toml:"Issues" yaml:"Issues"`
-	Pullrequests PullrequestSlice `boil:"Pullrequests" json:"Pullrequests" toml:"Pullrequests" yaml:"Pullrequests"`
+	OwnerUser                  *User                       `boil:"OwnerUser" json:"OwnerUser" toml:"OwnerUser" yaml:"OwnerUser"`
+	RepositoryInteractionLimit *RepositoryInteractionLimit `boil:"RepositoryInteractionLimit" json:"RepositoryInteractionLimit" toml:"RepositoryInteractionLimit" yaml:"RepositoryInteractionLimit"`
+	Collaborators              CollaboratorSlice           `boil:"Collaborators" json:"Collaborators" toml:"Collaborators" yaml:"Collaborators"`
+	Issues                     IssueSlice                  `boil:"Issues" json:"Issues" toml:"Issues" yaml:"Issues"`
+	Pullrequests               PullrequestSlice            `boil:"Pullrequests" json:"Pullrequests" toml:"Pullrequests" yaml:"Pullrequests"`
+	RepositoryInvitations      RepositoryInvitationSlice   `boil:"RepositoryInvitations" json:"RepositoryInvitations" toml:"RepositoryInvitations" yaml:"RepositoryInvitations"`
 }
 
 // NewStruct creates a new relationship struct
@@ -121,6 +116,20 @@ func (r *repositoryR) GetOwnerUser() *User {
 	return r.OwnerUser
 }
 
+func (r *repositoryR) GetRepositoryInteractionLimit() *RepositoryInteractionLimit {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryInteractionLimit
+}
+
+func (r *repositoryR) GetCollaborators() CollaboratorSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Collaborators
+}
+
 func (r *repositoryR) GetIssues() IssueSlice {
 	if r == nil {
 		return nil
@@ -135,13 +144,20 @@ func (r *repositoryR) GetPullrequests() PullrequestSlice {
 	return r.Pullrequests
 }
 
+func (r *repositoryR) GetRepositoryInvitations() RepositoryInvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryInvitations
+}
+
 // repositoryL is where Load methods for each relationship are stored.
 type repositoryL struct{}
 
 var (
-	repositoryAllColumns            = []string{"id", "owner", "name", "created_at"}
+	repositoryAllColumns            = []string{"id", "owner", "name", "visibility", "created_at"}
 	repositoryColumnsWithoutDefault = []string{"id", "owner", "name"}
-	repositoryColumnsWithDefault    = []string{"created_at"}
+	repositoryColumnsWithDefault    = []string{"visibility", "created_at"}
 	repositoryPrimaryKeyColumns     = []string{"id"}
 	repositoryGeneratedColumns      = []string{}
 )
@@ -435,6 +451,31 @@ func (o *Repository) OwnerUser(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// RepositoryInteractionLimit pointed to by the foreign key.
+func (o *Repository) RepositoryInteractionLimit(mods ...qm.QueryMod) repositoryInteractionLimitQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"repository\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return RepositoryInteractionLimits(queryMods...)
+}
+
+// Collaborators retrieves all the collaborator's Collaborators with an executor.
+func (o *Repository) Collaborators(mods ...qm.QueryMod) collaboratorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"collaborators\".\"repository\"=?", o.ID),
+	)
+
+	return Collaborators(queryMods...)
+}
+
 // Issues retrieves all the issue's Issues with an executor.
 func (o *Repository) Issues(mods ...qm.QueryMod) issueQuery {
 	var queryMods []qm.QueryMod
@@ -463,6 +504,20 @@ func (o *Repository) Pullrequests(mods ...qm.QueryMod) pullrequestQuery {
 	return Pullrequests(queryMods...)
 }
 
+// RepositoryInvitations retrieves all the repository_invitation's RepositoryInvitations with an executor.
+func (o *Repository) RepositoryInvitations(mods ...qm.QueryMod) repositoryInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"repository_invitations\".\"repository\"=?", o.ID),
+	)
+
+	return RepositoryInvitations(queryMods...)
+}
+
 // LoadOwnerUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (repositoryL) LoadOwnerUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRepository interface{}, mods queries.Applicator) error {
@@ -583,6 +638,237 @@ func (repositoryL) LoadOwnerUser(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
+// LoadRepositoryInteractionLimit allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (repositoryL) LoadRepositoryInteractionLimit(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRepository interface{}, mods queries.Applicator) error {
+	var slice []*Repository
+	var object *Repository
+
+	if singular {
+		var ok bool
+		object, ok = maybeRepository.(*Repository)
+		if !ok {
+			object = new(Repository)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRepository)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRepository))
+			}
+		}
+	} else {
+		s, ok := maybeRepository.(*[]*Repository)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRepository)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRepository))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &repositoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &repositoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`repository_interaction_limits`),
+		qm.WhereIn(`repository_interaction_limits.repository in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load RepositoryInteractionLimit")
+	}
+
+	var resultSlice []*RepositoryInteractionLimit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice RepositoryInteractionLimit")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for repository_interaction_limits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repository_interaction_limits")
+	}
+
+	if len(repositoryInteractionLimitAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RepositoryInteractionLimit = foreign
+		if foreign.R == nil {
+			foreign.R = &repositoryInteractionLimitR{}
+		}
+		foreign.R.RepositoryInteractionLimitRepository = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.Repository {
+				local.R.RepositoryInteractionLimit = foreign
+				if foreign.R == nil {
+					foreign.R = &repositoryInteractionLimitR{}
+				}
+				foreign.R.RepositoryInteractionLimitRepository = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCollaborators allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (repositoryL) LoadCollaborators(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRepository interface{}, mods queries.Applicator) error {
+	var slice []*Repository
+	var object *Repository
+
+	if singular {
+		var ok bool
+		object, ok = maybeRepository.(*Repository)
+		if !ok {
+			object = new(Repository)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRepository)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRepository))
+			}
+		}
+	} else {
+		s, ok := maybeRepository.(*[]*Repository)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRepository)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRepository))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &repositoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &repositoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`collaborators`),
+		qm.WhereIn(`collaborators.repository in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load collaborators")
+	}
+
+	var resultSlice []*Collaborator
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice collaborators")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on collaborators")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collaborators")
+	}
+
+	if len(collaboratorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Collaborators = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &collaboratorR{}
+			}
+			foreign.R.CollaboratorRepository = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Repository {
+				local.R.Collaborators = append(local.R.Collaborators, foreign)
+				if foreign.R == nil {
+					foreign.R = &collaboratorR{}
+				}
+				foreign.R.CollaboratorRepository = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadIssues allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (repositoryL) LoadIssues(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRepository interface{}, mods queries.Applicator) error {
@@ -811,6 +1097,120 @@ func (repositoryL) LoadPullrequests(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
+// LoadRepositoryInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (repositoryL) LoadRepositoryInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRepository interface{}, mods queries.Applicator) error {
+	var slice []*Repository
+	var object *Repository
+
+	if singular {
+		var ok bool
+		object, ok = maybeRepository.(*Repository)
+		if !ok {
+			object = new(Repository)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRepository)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRepository))
+			}
+		}
+	} else {
+		s, ok := maybeRepository.(*[]*Repository)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRepository)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRepository))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &repositoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &repositoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`repository_invitations`),
+		qm.WhereIn(`repository_invitations.repository in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load repository_invitations")
+	}
+
+	var resultSlice []*RepositoryInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice repository_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on repository_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repository_invitations")
+	}
+
+	if len(repositoryInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RepositoryInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &repositoryInvitationR{}
+			}
+			foreign.R.RepositoryInvitationRepository = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Repository {
+				local.R.RepositoryInvitations = append(local.R.RepositoryInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &repositoryInvitationR{}
+				}
+				foreign.R.RepositoryInvitationRepository = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetOwnerUser of the repository to the related item.
 // Sets o.R.OwnerUser to related.
 // Adds o to related.R.OwnerRepositories.
@@ -858,6 +1258,109 @@ func (o *Repository) SetOwnerUser(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// SetRepositoryInteractionLimit of the repository to the related item.
+// Sets o.R.RepositoryInteractionLimit to related.
+// Adds o to related.R.RepositoryInteractionLimitRepository.
+func (o *Repository) SetRepositoryInteractionLimit(ctx context.Context, exec boil.ContextExecutor, insert bool, related *RepositoryInteractionLimit) error {
+	var err error
+
+	if insert {
+		related.Repository = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"repository_interaction_limits\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"repository"}),
+			strmangle.WhereClause("\"", "\"", 0, repositoryInteractionLimitPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.Repository}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.Repository = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &repositoryR{
+			RepositoryInteractionLimit: related,
+		}
+	} else {
+		o.R.RepositoryInteractionLimit = related
+	}
+
+	if related.R == nil {
+		related.R = &repositoryInteractionLimitR{
+			RepositoryInteractionLimitRepository: o,
+		}
+	} else {
+		related.R.RepositoryInteractionLimitRepository = o
+	}
+	return nil
+}
+
+// AddCollaborators adds the given related objects to the existing relationships
+// of the repository, optionally inserting them as new records.
+// Appends related to o.R.Collaborators.
+// Sets related.R.CollaboratorRepository appropriately.
+func (o *Repository) AddCollaborators(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Collaborator) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Repository = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"collaborators\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"repository"}),
+				strmangle.WhereClause("\"", "\"", 0, collaboratorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Repository, rel.Collaborator}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Repository = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &repositoryR{
+			Collaborators: related,
+		}
+	} else {
+		o.R.Collaborators = append(o.R.Collaborators, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collaboratorR{
+				CollaboratorRepository: o,
+			}
+		} else {
+			rel.R.CollaboratorRepository = o
+		}
+	}
+	return nil
+}
+
 // AddIssues adds the given related objects to the existing relationships
 // of the repository, optionally inserting them as new records.
 // Appends related to o.R.Issues.
@@ -964,6 +1467,59 @@ func (o *Repository) AddPullrequests(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
+// AddRepositoryInvitations adds the given related objects to the existing relationships
+// of the repository, optionally inserting them as new records.
+// Appends related to o.R.RepositoryInvitations.
+// Sets related.R.RepositoryInvitationRepository appropriately.
+func (o *Repository) AddRepositoryInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RepositoryInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Repository = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"repository_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"repository"}),
+				strmangle.WhereClause("\"", "\"", 0, repositoryInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Repository = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &repositoryR{
+			RepositoryInvitations: related,
+		}
+	} else {
+		o.R.RepositoryInvitations = append(o.R.RepositoryInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &repositoryInvitationR{
+				RepositoryInvitationRepository: o,
+			}
+		} else {
+			rel.R.RepositoryInvitationRepository = o
+		}
+	}
+	return nil
+}
+
 // Repositories retrieves all the records using an executor.
 func Repositories(mods ...qm.QueryMod) repositoryQuery {
 	mods = append(mods, qm.From("\"repositories\""))
@@ -0,0 +1,1090 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RepositoryInteractionLimit is an object representing the database table.
+type RepositoryInteractionLimit struct {
+	Repository       string    `boil:"repository" json:"repository" toml:"repository" yaml:"repository"`
+	InteractionLimit string    `boil:"interaction_limit" json:"interaction_limit" toml:"interaction_limit" yaml:"interaction_limit"`
+	ExpiresAt        time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *repositoryInteractionLimitR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L repositoryInteractionLimitL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RepositoryInteractionLimitColumns = struct {
+	Repository       string
+	InteractionLimit string
+	ExpiresAt        string
+}{
+	Repository:       "repository",
+	InteractionLimit: "interaction_limit",
+	ExpiresAt:        "expires_at",
+}
+
+var RepositoryInteractionLimitTableColumns = struct {
+	Repository       string
+	InteractionLimit string
+	ExpiresAt        string
+}{
+	Repository:       "repository_interaction_limits.repository",
+	InteractionLimit: "repository_interaction_limits.interaction_limit",
+	ExpiresAt:        "repository_interaction_limits.expires_at",
+}
+
+// Generated where
+
+var RepositoryInteractionLimitWhere = struct {
+	Repository       whereHelperstring
+	InteractionLimit whereHelperstring
+	ExpiresAt        whereHelpertime_Time
+}{
+	Repository:       whereHelperstring{field: "\"repository_interaction_limits\".\"repository\""},
+	InteractionLimit: whereHelperstring{field: "\"repository_interaction_limits\".\"interaction_limit\""},
+	ExpiresAt:        whereHelpertime_Time{field: "\"repository_interaction_limits\".\"expires_at\""},
+}
+
+// RepositoryInteractionLimitRels is where relationship names are stored.
+var RepositoryInteractionLimitRels = struct {
+	RepositoryInteractionLimitRepository string
+}{
+	RepositoryInteractionLimitRepository: "RepositoryInteractionLimitRepository",
+}
+
+// repositoryInteractionLimitR is where relationships are stored.
+type repositoryInteractionLimitR struct {
+	RepositoryInteractionLimitRepository *Repository `boil:"RepositoryInteractionLimitRepository" json:"RepositoryInteractionLimitRepository" toml:"RepositoryInteractionLimitRepository" yaml:"RepositoryInteractionLimitRepository"`
+}
+
+// NewStruct creates a new relationship struct
+func (*repositoryInteractionLimitR) NewStruct() *repositoryInteractionLimitR {
+	return &repositoryInteractionLimitR{}
+}
+
+func (r *repositoryInteractionLimitR) GetRepositoryInteractionLimitRepository() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryInteractionLimitRepository
+}
+
+// repositoryInteractionLimitL is where Load methods for each relationship are stored.
+type repositoryInteractionLimitL struct{}
+
+var (
+	repositoryInteractionLimitAllColumns            = []string{"repository", "interaction_limit", "expires_at"}
+	repositoryInteractionLimitColumnsWithoutDefault = []string{"repository", "interaction_limit", "expires_at"}
+	repositoryInteractionLimitColumnsWithDefault    = []string{}
+	repositoryInteractionLimitPrimaryKeyColumns     = []string{"repository"}
+	repositoryInteractionLimitGeneratedColumns      = []string{}
+)
+
+type (
+	// RepositoryInteractionLimitSlice is an alias for a slice of pointers to RepositoryInteractionLimit.
+	// This should almost always be used instead of []RepositoryInteractionLimit.
+	RepositoryInteractionLimitSlice []*RepositoryInteractionLimit
+	// RepositoryInteractionLimitHook is the signature for custom RepositoryInteractionLimit hook methods
+	RepositoryInteractionLimitHook func(context.Context, boil.ContextExecutor, *RepositoryInteractionLimit) error
+
+	repositoryInteractionLimitQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	repositoryInteractionLimitType                 = reflect.TypeOf(&RepositoryInteractionLimit{})
+	repositoryInteractionLimitMapping              = queries.MakeStructMapping(repositoryInteractionLimitType)
+	repositoryInteractionLimitPrimaryKeyMapping, _ = queries.BindMapping(repositoryInteractionLimitType, repositoryInteractionLimitMapping, repositoryInteractionLimitPrimaryKeyColumns)
+	repositoryInteractionLimitInsertCacheMut       sync.RWMutex
+	repositoryInteractionLimitInsertCache          = make(map[string]insertCache)
+	repositoryInteractionLimitUpdateCacheMut       sync.RWMutex
+	repositoryInteractionLimitUpdateCache          = make(map[string]updateCache)
+	repositoryInteractionLimitUpsertCacheMut       sync.RWMutex
+	repositoryInteractionLimitUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var repositoryInteractionLimitAfterSelectHooks []RepositoryInteractionLimitHook
+
+var repositoryInteractionLimitBeforeInsertHooks []RepositoryInteractionLimitHook
+var repositoryInteractionLimitAfterInsertHooks []RepositoryInteractionLimitHook
+
+var repositoryInteractionLimitBeforeUpdateHooks []RepositoryInteractionLimitHook
+var repositoryInteractionLimitAfterUpdateHooks []RepositoryInteractionLimitHook
+
+var repositoryInteractionLimitBeforeDeleteHooks []RepositoryInteractionLimitHook
+var repositoryInteractionLimitAfterDeleteHooks []RepositoryInteractionLimitHook
+
+var repositoryInteractionLimitBeforeUpsertHooks []RepositoryInteractionLimitHook
+var repositoryInteractionLimitAfterUpsertHooks []RepositoryInteractionLimitHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RepositoryInteractionLimit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInteractionLimitAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RepositoryInteractionLimit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInteractionLimitBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RepositoryInteractionLimit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInteractionLimitAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RepositoryInteractionLimit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInteractionLimitBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RepositoryInteractionLimit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInteractionLimitAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RepositoryInteractionLimit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInteractionLimitBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RepositoryInteractionLimit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInteractionLimitAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RepositoryInteractionLimit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInteractionLimitBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RepositoryInteractionLimit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInteractionLimitAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRepositoryInteractionLimitHook registers your hook function for all future operations.
+func AddRepositoryInteractionLimitHook(hookPoint boil.HookPoint, repositoryInteractionLimitHook RepositoryInteractionLimitHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		repositoryInteractionLimitAfterSelectHooks = append(repositoryInteractionLimitAfterSelectHooks, repositoryInteractionLimitHook)
+	case boil.BeforeInsertHook:
+		repositoryInteractionLimitBeforeInsertHooks = append(repositoryInteractionLimitBeforeInsertHooks, repositoryInteractionLimitHook)
+	case boil.AfterInsertHook:
+		repositoryInteractionLimitAfterInsertHooks = append(repositoryInteractionLimitAfterInsertHooks, repositoryInteractionLimitHook)
+	case boil.BeforeUpdateHook:
+		repositoryInteractionLimitBeforeUpdateHooks = append(repositoryInteractionLimitBeforeUpdateHooks, repositoryInteractionLimitHook)
+	case boil.AfterUpdateHook:
+		repositoryInteractionLimitAfterUpdateHooks = append(repositoryInteractionLimitAfterUpdateHooks, repositoryInteractionLimitHook)
+	case boil.BeforeDeleteHook:
+		repositoryInteractionLimitBeforeDeleteHooks = append(repositoryInteractionLimitBeforeDeleteHooks, repositoryInteractionLimitHook)
+	case boil.AfterDeleteHook:
+		repositoryInteractionLimitAfterDeleteHooks = append(repositoryInteractionLimitAfterDeleteHooks, repositoryInteractionLimitHook)
+	case boil.BeforeUpsertHook:
+		repositoryInteractionLimitBeforeUpsertHooks = append(repositoryInteractionLimitBeforeUpsertHooks, repositoryInteractionLimitHook)
+	case boil.AfterUpsertHook:
+		repositoryInteractionLimitAfterUpsertHooks = append(repositoryInteractionLimitAfterUpsertHooks, repositoryInteractionLimitHook)
+	}
+}
+
+// One returns a single repositoryInteractionLimit record from the query.
+func (q repositoryInteractionLimitQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RepositoryInteractionLimit, error) {
+	o := &RepositoryInteractionLimit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for repository_interaction_limits")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RepositoryInteractionLimit records from the query.
+func (q repositoryInteractionLimitQuery) All(ctx context.Context, exec boil.ContextExecutor) (RepositoryInteractionLimitSlice, error) {
+	var o []*RepositoryInteractionLimit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to RepositoryInteractionLimit slice")
+	}
+
+	if len(repositoryInteractionLimitAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RepositoryInteractionLimit records in the query.
+func (q repositoryInteractionLimitQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count repository_interaction_limits rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q repositoryInteractionLimitQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if repository_interaction_limits exists")
+	}
+
+	return count > 0, nil
+}
+
+// RepositoryInteractionLimitRepository pointed to by the foreign key.
+func (o *RepositoryInteractionLimit) RepositoryInteractionLimitRepository(mods ...qm.QueryMod) repositoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Repository),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Repositories(queryMods...)
+}
+
+// LoadRepositoryInteractionLimitRepository allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (repositoryInteractionLimitL) LoadRepositoryInteractionLimitRepository(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRepositoryInteractionLimit interface{}, mods queries.Applicator) error {
+	var slice []*RepositoryInteractionLimit
+	var object *RepositoryInteractionLimit
+
+	if singular {
+		var ok bool
+		object, ok = maybeRepositoryInteractionLimit.(*RepositoryInteractionLimit)
+		if !ok {
+			object = new(RepositoryInteractionLimit)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRepositoryInteractionLimit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRepositoryInteractionLimit))
+			}
+		}
+	} else {
+		s, ok := maybeRepositoryInteractionLimit.(*[]*RepositoryInteractionLimit)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRepositoryInteractionLimit)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRepositoryInteractionLimit))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &repositoryInteractionLimitR{}
+		}
+		args = append(args, object.Repository)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &repositoryInteractionLimitR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Repository {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Repository)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`repositories`),
+		qm.WhereIn(`repositories.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Repository")
+	}
+
+	var resultSlice []*Repository
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Repository")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for repositories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repositories")
+	}
+
+	if len(repositoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RepositoryInteractionLimitRepository = foreign
+		if foreign.R == nil {
+			foreign.R = &repositoryR{}
+		}
+		foreign.R.RepositoryInteractionLimit = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Repository == foreign.ID {
+				local.R.RepositoryInteractionLimitRepository = foreign
+				if foreign.R == nil {
+					foreign.R = &repositoryR{}
+				}
+				foreign.R.RepositoryInteractionLimit = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRepositoryInteractionLimitRepository of the repositoryInteractionLimit to the related item.
+// Sets o.R.RepositoryInteractionLimitRepository to related.
+// Adds o to related.R.RepositoryInteractionLimit.
+func (o *RepositoryInteractionLimit) SetRepositoryInteractionLimitRepository(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Repository) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"repository_interaction_limits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"repository"}),
+		strmangle.WhereClause("\"", "\"", 0, repositoryInteractionLimitPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Repository}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Repository = related.ID
+	if o.R == nil {
+		o.R = &repositoryInteractionLimitR{
+			RepositoryInteractionLimitRepository: related,
+		}
+	} else {
+		o.R.RepositoryInteractionLimitRepository = related
+	}
+
+	if related.R == nil {
+		related.R = &repositoryR{
+			RepositoryInteractionLimit: o,
+		}
+	} else {
+		related.R.RepositoryInteractionLimit = o
+	}
+
+	return nil
+}
+
+// RepositoryInteractionLimits retrieves all the records using an executor.
+func RepositoryInteractionLimits(mods ...qm.QueryMod) repositoryInteractionLimitQuery {
+	mods = append(mods, qm.From("\"repository_interaction_limits\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"repository_interaction_limits\".*"})
+	}
+
+	return repositoryInteractionLimitQuery{q}
+}
+
+// FindRepositoryInteractionLimit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRepositoryInteractionLimit(ctx context.Context, exec boil.ContextExecutor, repository string, selectCols ...string) (*RepositoryInteractionLimit, error) {
+	repositoryInteractionLimitObj := &RepositoryInteractionLimit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"repository_interaction_limits\" where \"repository\"=?", sel,
+	)
+
+	q := queries.Raw(query, repository)
+
+	err := q.Bind(ctx, exec, repositoryInteractionLimitObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from repository_interaction_limits")
+	}
+
+	if err = repositoryInteractionLimitObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return repositoryInteractionLimitObj, err
+	}
+
+	return repositoryInteractionLimitObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RepositoryInteractionLimit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no repository_interaction_limits provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(repositoryInteractionLimitColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	repositoryInteractionLimitInsertCacheMut.RLock()
+	cache, cached := repositoryInteractionLimitInsertCache[key]
+	repositoryInteractionLimitInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			repositoryInteractionLimitAllColumns,
+			repositoryInteractionLimitColumnsWithDefault,
+			repositoryInteractionLimitColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(repositoryInteractionLimitType, repositoryInteractionLimitMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(repositoryInteractionLimitType, repositoryInteractionLimitMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"repository_interaction_limits\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"repository_interaction_limits\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into repository_interaction_limits")
+	}
+
+	if !cached {
+		repositoryInteractionLimitInsertCacheMut.Lock()
+		repositoryInteractionLimitInsertCache[key] = cache
+		repositoryInteractionLimitInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RepositoryInteractionLimit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RepositoryInteractionLimit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	repositoryInteractionLimitUpdateCacheMut.RLock()
+	cache, cached := repositoryInteractionLimitUpdateCache[key]
+	repositoryInteractionLimitUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			repositoryInteractionLimitAllColumns,
+			repositoryInteractionLimitPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update repository_interaction_limits, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"repository_interaction_limits\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, repositoryInteractionLimitPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(repositoryInteractionLimitType, repositoryInteractionLimitMapping, append(wl, repositoryInteractionLimitPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update repository_interaction_limits row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for repository_interaction_limits")
+	}
+
+	if !cached {
+		repositoryInteractionLimitUpdateCacheMut.Lock()
+		repositoryInteractionLimitUpdateCache[key] = cache
+		repositoryInteractionLimitUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q repositoryInteractionLimitQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for repository_interaction_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for repository_interaction_limits")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RepositoryInteractionLimitSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), repositoryInteractionLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"repository_interaction_limits\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, repositoryInteractionLimitPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in repositoryInteractionLimit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all repositoryInteractionLimit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RepositoryInteractionLimit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no repository_interaction_limits provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(repositoryInteractionLimitColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	repositoryInteractionLimitUpsertCacheMut.RLock()
+	cache, cached := repositoryInteractionLimitUpsertCache[key]
+	repositoryInteractionLimitUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			repositoryInteractionLimitAllColumns,
+			repositoryInteractionLimitColumnsWithDefault,
+			repositoryInteractionLimitColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			repositoryInteractionLimitAllColumns,
+			repositoryInteractionLimitPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("db: unable to upsert repository_interaction_limits, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(repositoryInteractionLimitPrimaryKeyColumns))
+			copy(conflict, repositoryInteractionLimitPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"repository_interaction_limits\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(repositoryInteractionLimitType, repositoryInteractionLimitMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(repositoryInteractionLimitType, repositoryInteractionLimitMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "db: unable to upsert repository_interaction_limits")
+	}
+
+	if !cached {
+		repositoryInteractionLimitUpsertCacheMut.Lock()
+		repositoryInteractionLimitUpsertCache[key] = cache
+		repositoryInteractionLimitUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single RepositoryInteractionLimit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RepositoryInteractionLimit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no RepositoryInteractionLimit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), repositoryInteractionLimitPrimaryKeyMapping)
+	sql := "DELETE FROM \"repository_interaction_limits\" WHERE \"repository\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from repository_interaction_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for repository_interaction_limits")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q repositoryInteractionLimitQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no repositoryInteractionLimitQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from repository_interaction_limits")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for repository_interaction_limits")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RepositoryInteractionLimitSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(repositoryInteractionLimitBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), repositoryInteractionLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"repository_interaction_limits\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, repositoryInteractionLimitPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from repositoryInteractionLimit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for repository_interaction_limits")
+	}
+
+	if len(repositoryInteractionLimitAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RepositoryInteractionLimit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRepositoryInteractionLimit(ctx, exec, o.Repository)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RepositoryInteractionLimitSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RepositoryInteractionLimitSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), repositoryInteractionLimitPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"repository_interaction_limits\".* FROM \"repository_interaction_limits\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, repositoryInteractionLimitPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in RepositoryInteractionLimitSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RepositoryInteractionLimitExists checks if the RepositoryInteractionLimit row exists.
+func RepositoryInteractionLimitExists(ctx context.Context, exec boil.ContextExecutor, repository string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"repository_interaction_limits\" where \"repository\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, repository)
+	}
+	row := exec.QueryRowContext(ctx, sql, repository)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if repository_interaction_limits exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RepositoryInteractionLimit row exists.
+func (o *RepositoryInteractionLimit) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RepositoryInteractionLimitExists(ctx, exec, o.Repository)
+}
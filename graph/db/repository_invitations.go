@@ -0,0 +1,1501 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RepositoryInvitation is an object representing the database table.
+type RepositoryInvitation struct {
+	ID         string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Repository string    `boil:"repository" json:"repository" toml:"repository" yaml:"repository"`
+	Invitee    string    `boil:"invitee" json:"invitee" toml:"invitee" yaml:"invitee"`
+	Inviter    string    `boil:"inviter" json:"inviter" toml:"inviter" yaml:"inviter"`
+	Permission string    `boil:"permission" json:"permission" toml:"permission" yaml:"permission"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *repositoryInvitationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L repositoryInvitationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RepositoryInvitationColumns = struct {
+	ID         string
+	Repository string
+	Invitee    string
+	Inviter    string
+	Permission string
+	CreatedAt  string
+}{
+	ID:         "id",
+	Repository: "repository",
+	Invitee:    "invitee",
+	Inviter:    "inviter",
+	Permission: "permission",
+	CreatedAt:  "created_at",
+}
+
+var RepositoryInvitationTableColumns = struct {
+	ID         string
+	Repository string
+	Invitee    string
+	Inviter    string
+	Permission string
+	CreatedAt  string
+}{
+	ID:         "repository_invitations.id",
+	Repository: "repository_invitations.repository",
+	Invitee:    "repository_invitations.invitee",
+	Inviter:    "repository_invitations.inviter",
+	Permission: "repository_invitations.permission",
+	CreatedAt:  "repository_invitations.created_at",
+}
+
+// Generated where
+
+var RepositoryInvitationWhere = struct {
+	ID         whereHelperstring
+	Repository whereHelperstring
+	Invitee    whereHelperstring
+	Inviter    whereHelperstring
+	Permission whereHelperstring
+	CreatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperstring{field: "\"repository_invitations\".\"id\""},
+	Repository: whereHelperstring{field: "\"repository_invitations\".\"repository\""},
+	Invitee:    whereHelperstring{field: "\"repository_invitations\".\"invitee\""},
+	Inviter:    whereHelperstring{field: "\"repository_invitations\".\"inviter\""},
+	Permission: whereHelperstring{field: "\"repository_invitations\".\"permission\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"repository_invitations\".\"created_at\""},
+}
+
+// RepositoryInvitationRels is where relationship names are stored.
+var RepositoryInvitationRels = struct {
+	InviterUser                    string
+	InviteeUser                    string
+	RepositoryInvitationRepository string
+}{
+	InviterUser:                    "InviterUser",
+	InviteeUser:                    "InviteeUser",
+	RepositoryInvitationRepository: "RepositoryInvitationRepository",
+}
+
+// repositoryInvitationR is where relationships are stored.
+type repositoryInvitationR struct {
+	InviterUser                    *User       `boil:"InviterUser" json:"InviterUser" toml:"InviterUser" yaml:"InviterUser"`
+	InviteeUser                    *User       `boil:"InviteeUser" json:"InviteeUser" toml:"InviteeUser" yaml:"InviteeUser"`
+	RepositoryInvitationRepository *Repository `boil:"RepositoryInvitationRepository" json:"RepositoryInvitationRepository" toml:"RepositoryInvitationRepository" yaml:"RepositoryInvitationRepository"`
+}
+
+// NewStruct creates a new relationship struct
+func (*repositoryInvitationR) NewStruct() *repositoryInvitationR {
+	return &repositoryInvitationR{}
+}
+
+func (r *repositoryInvitationR) GetInviterUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.InviterUser
+}
+
+func (r *repositoryInvitationR) GetInviteeUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.InviteeUser
+}
+
+func (r *repositoryInvitationR) GetRepositoryInvitationRepository() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryInvitationRepository
+}
+
+// repositoryInvitationL is where Load methods for each relationship are stored.
+type repositoryInvitationL struct{}
+
+var (
+	repositoryInvitationAllColumns            = []string{"id", "repository", "invitee", "inviter", "permission", "created_at"}
+	repositoryInvitationColumnsWithoutDefault = []string{"id", "repository", "invitee", "inviter", "permission"}
+	repositoryInvitationColumnsWithDefault    = []string{"created_at"}
+	repositoryInvitationPrimaryKeyColumns     = []string{"id"}
+	repositoryInvitationGeneratedColumns      = []string{}
+)
+
+type (
+	// RepositoryInvitationSlice is an alias for a slice of pointers to RepositoryInvitation.
+	// This should almost always be used instead of []RepositoryInvitation.
+	RepositoryInvitationSlice []*RepositoryInvitation
+	// RepositoryInvitationHook is the signature for custom RepositoryInvitation hook methods
+	RepositoryInvitationHook func(context.Context, boil.ContextExecutor, *RepositoryInvitation) error
+
+	repositoryInvitationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	repositoryInvitationType                 = reflect.TypeOf(&RepositoryInvitation{})
+	repositoryInvitationMapping              = queries.MakeStructMapping(repositoryInvitationType)
+	repositoryInvitationPrimaryKeyMapping, _ = queries.BindMapping(repositoryInvitationType, repositoryInvitationMapping, repositoryInvitationPrimaryKeyColumns)
+	repositoryInvitationInsertCacheMut       sync.RWMutex
+	repositoryInvitationInsertCache          = make(map[string]insertCache)
+	repositoryInvitationUpdateCacheMut       sync.RWMutex
+	repositoryInvitationUpdateCache          = make(map[string]updateCache)
+	repositoryInvitationUpsertCacheMut       sync.RWMutex
+	repositoryInvitationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var repositoryInvitationAfterSelectHooks []RepositoryInvitationHook
+
+var repositoryInvitationBeforeInsertHooks []RepositoryInvitationHook
+var repositoryInvitationAfterInsertHooks []RepositoryInvitationHook
+
+var repositoryInvitationBeforeUpdateHooks []RepositoryInvitationHook
+var repositoryInvitationAfterUpdateHooks []RepositoryInvitationHook
+
+var repositoryInvitationBeforeDeleteHooks []RepositoryInvitationHook
+var repositoryInvitationAfterDeleteHooks []RepositoryInvitationHook
+
+var repositoryInvitationBeforeUpsertHooks []RepositoryInvitationHook
+var repositoryInvitationAfterUpsertHooks []RepositoryInvitationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RepositoryInvitation) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInvitationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RepositoryInvitation) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInvitationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RepositoryInvitation) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInvitationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RepositoryInvitation) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInvitationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RepositoryInvitation) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInvitationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RepositoryInvitation) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInvitationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RepositoryInvitation) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInvitationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RepositoryInvitation) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInvitationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RepositoryInvitation) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range repositoryInvitationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRepositoryInvitationHook registers your hook function for all future operations.
+func AddRepositoryInvitationHook(hookPoint boil.HookPoint, repositoryInvitationHook RepositoryInvitationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		repositoryInvitationAfterSelectHooks = append(repositoryInvitationAfterSelectHooks, repositoryInvitationHook)
+	case boil.BeforeInsertHook:
+		repositoryInvitationBeforeInsertHooks = append(repositoryInvitationBeforeInsertHooks, repositoryInvitationHook)
+	case boil.AfterInsertHook:
+		repositoryInvitationAfterInsertHooks = append(repositoryInvitationAfterInsertHooks, repositoryInvitationHook)
+	case boil.BeforeUpdateHook:
+		repositoryInvitationBeforeUpdateHooks = append(repositoryInvitationBeforeUpdateHooks, repositoryInvitationHook)
+	case boil.AfterUpdateHook:
+		repositoryInvitationAfterUpdateHooks = append(repositoryInvitationAfterUpdateHooks, repositoryInvitationHook)
+	case boil.BeforeDeleteHook:
+		repositoryInvitationBeforeDeleteHooks = append(repositoryInvitationBeforeDeleteHooks, repositoryInvitationHook)
+	case boil.AfterDeleteHook:
+		repositoryInvitationAfterDeleteHooks = append(repositoryInvitationAfterDeleteHooks, repositoryInvitationHook)
+	case boil.BeforeUpsertHook:
+		repositoryInvitationBeforeUpsertHooks = append(repositoryInvitationBeforeUpsertHooks, repositoryInvitationHook)
+	case boil.AfterUpsertHook:
+		repositoryInvitationAfterUpsertHooks = append(repositoryInvitationAfterUpsertHooks, repositoryInvitationHook)
+	}
+}
+
+// One returns a single repositoryInvitation record from the query.
+func (q repositoryInvitationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RepositoryInvitation, error) {
+	o := &RepositoryInvitation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: failed to execute a one query for repository_invitations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RepositoryInvitation records from the query.
+func (q repositoryInvitationQuery) All(ctx context.Context, exec boil.ContextExecutor) (RepositoryInvitationSlice, error) {
+	var o []*RepositoryInvitation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "db: failed to assign all query results to RepositoryInvitation slice")
+	}
+
+	if len(repositoryInvitationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RepositoryInvitation records in the query.
+func (q repositoryInvitationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to count repository_invitations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q repositoryInvitationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "db: failed to check if repository_invitations exists")
+	}
+
+	return count > 0, nil
+}
+
+// InviterUser pointed to by the foreign key.
+func (o *RepositoryInvitation) InviterUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Inviter),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// InviteeUser pointed to by the foreign key.
+func (o *RepositoryInvitation) InviteeUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Invitee),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// RepositoryInvitationRepository pointed to by the foreign key.
+func (o *RepositoryInvitation) RepositoryInvitationRepository(mods ...qm.QueryMod) repositoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Repository),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Repositories(queryMods...)
+}
+
+// LoadInviterUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (repositoryInvitationL) LoadInviterUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRepositoryInvitation interface{}, mods queries.Applicator) error {
+	var slice []*RepositoryInvitation
+	var object *RepositoryInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeRepositoryInvitation.(*RepositoryInvitation)
+		if !ok {
+			object = new(RepositoryInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRepositoryInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRepositoryInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeRepositoryInvitation.(*[]*RepositoryInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRepositoryInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRepositoryInvitation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &repositoryInvitationR{}
+		}
+		args = append(args, object.Inviter)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &repositoryInvitationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Inviter {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Inviter)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.InviterUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.InviterRepositoryInvitations = append(foreign.R.InviterRepositoryInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Inviter == foreign.ID {
+				local.R.InviterUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.InviterRepositoryInvitations = append(foreign.R.InviterRepositoryInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInviteeUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (repositoryInvitationL) LoadInviteeUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRepositoryInvitation interface{}, mods queries.Applicator) error {
+	var slice []*RepositoryInvitation
+	var object *RepositoryInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeRepositoryInvitation.(*RepositoryInvitation)
+		if !ok {
+			object = new(RepositoryInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRepositoryInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRepositoryInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeRepositoryInvitation.(*[]*RepositoryInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRepositoryInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRepositoryInvitation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &repositoryInvitationR{}
+		}
+		args = append(args, object.Invitee)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &repositoryInvitationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Invitee {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Invitee)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.InviteeUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.InviteeRepositoryInvitations = append(foreign.R.InviteeRepositoryInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Invitee == foreign.ID {
+				local.R.InviteeUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.InviteeRepositoryInvitations = append(foreign.R.InviteeRepositoryInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRepositoryInvitationRepository allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (repositoryInvitationL) LoadRepositoryInvitationRepository(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRepositoryInvitation interface{}, mods queries.Applicator) error {
+	var slice []*RepositoryInvitation
+	var object *RepositoryInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeRepositoryInvitation.(*RepositoryInvitation)
+		if !ok {
+			object = new(RepositoryInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRepositoryInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRepositoryInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeRepositoryInvitation.(*[]*RepositoryInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRepositoryInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRepositoryInvitation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &repositoryInvitationR{}
+		}
+		args = append(args, object.Repository)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &repositoryInvitationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Repository {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Repository)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`repositories`),
+		qm.WhereIn(`repositories.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Repository")
+	}
+
+	var resultSlice []*Repository
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Repository")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for repositories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repositories")
+	}
+
+	if len(repositoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RepositoryInvitationRepository = foreign
+		if foreign.R == nil {
+			foreign.R = &repositoryR{}
+		}
+		foreign.R.RepositoryInvitations = append(foreign.R.RepositoryInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Repository == foreign.ID {
+				local.R.RepositoryInvitationRepository = foreign
+				if foreign.R == nil {
+					foreign.R = &repositoryR{}
+				}
+				foreign.R.RepositoryInvitations = append(foreign.R.RepositoryInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetInviterUser of the repositoryInvitation to the related item.
+// Sets o.R.InviterUser to related.
+// Adds o to related.R.InviterRepositoryInvitations.
+func (o *RepositoryInvitation) SetInviterUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"repository_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"inviter"}),
+		strmangle.WhereClause("\"", "\"", 0, repositoryInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Inviter = related.ID
+	if o.R == nil {
+		o.R = &repositoryInvitationR{
+			InviterUser: related,
+		}
+	} else {
+		o.R.InviterUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			InviterRepositoryInvitations: RepositoryInvitationSlice{o},
+		}
+	} else {
+		related.R.InviterRepositoryInvitations = append(related.R.InviterRepositoryInvitations, o)
+	}
+
+	return nil
+}
+
+// SetInviteeUser of the repositoryInvitation to the related item.
+// Sets o.R.InviteeUser to related.
+// Adds o to related.R.InviteeRepositoryInvitations.
+func (o *RepositoryInvitation) SetInviteeUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"repository_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"invitee"}),
+		strmangle.WhereClause("\"", "\"", 0, repositoryInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Invitee = related.ID
+	if o.R == nil {
+		o.R = &repositoryInvitationR{
+			InviteeUser: related,
+		}
+	} else {
+		o.R.InviteeUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			InviteeRepositoryInvitations: RepositoryInvitationSlice{o},
+		}
+	} else {
+		related.R.InviteeRepositoryInvitations = append(related.R.InviteeRepositoryInvitations, o)
+	}
+
+	return nil
+}
+
+// SetRepositoryInvitationRepository of the repositoryInvitation to the related item.
+// Sets o.R.RepositoryInvitationRepository to related.
+// Adds o to related.R.RepositoryInvitations.
+func (o *RepositoryInvitation) SetRepositoryInvitationRepository(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Repository) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"repository_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"repository"}),
+		strmangle.WhereClause("\"", "\"", 0, repositoryInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Repository = related.ID
+	if o.R == nil {
+		o.R = &repositoryInvitationR{
+			RepositoryInvitationRepository: related,
+		}
+	} else {
+		o.R.RepositoryInvitationRepository = related
+	}
+
+	if related.R == nil {
+		related.R = &repositoryR{
+			RepositoryInvitations: RepositoryInvitationSlice{o},
+		}
+	} else {
+		related.R.RepositoryInvitations = append(related.R.RepositoryInvitations, o)
+	}
+
+	return nil
+}
+
+// RepositoryInvitations retrieves all the records using an executor.
+func RepositoryInvitations(mods ...qm.QueryMod) repositoryInvitationQuery {
+	mods = append(mods, qm.From("\"repository_invitations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"repository_invitations\".*"})
+	}
+
+	return repositoryInvitationQuery{q}
+}
+
+// FindRepositoryInvitation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRepositoryInvitation(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*RepositoryInvitation, error) {
+	repositoryInvitationObj := &RepositoryInvitation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"repository_invitations\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, repositoryInvitationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "db: unable to select from repository_invitations")
+	}
+
+	if err = repositoryInvitationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return repositoryInvitationObj, err
+	}
+
+	return repositoryInvitationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RepositoryInvitation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no repository_invitations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(repositoryInvitationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	repositoryInvitationInsertCacheMut.RLock()
+	cache, cached := repositoryInvitationInsertCache[key]
+	repositoryInvitationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			repositoryInvitationAllColumns,
+			repositoryInvitationColumnsWithDefault,
+			repositoryInvitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(repositoryInvitationType, repositoryInvitationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(repositoryInvitationType, repositoryInvitationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"repository_invitations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"repository_invitations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "db: unable to insert into repository_invitations")
+	}
+
+	if !cached {
+		repositoryInvitationInsertCacheMut.Lock()
+		repositoryInvitationInsertCache[key] = cache
+		repositoryInvitationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RepositoryInvitation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RepositoryInvitation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	repositoryInvitationUpdateCacheMut.RLock()
+	cache, cached := repositoryInvitationUpdateCache[key]
+	repositoryInvitationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			repositoryInvitationAllColumns,
+			repositoryInvitationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("db: unable to update repository_invitations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"repository_invitations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, repositoryInvitationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(repositoryInvitationType, repositoryInvitationMapping, append(wl, repositoryInvitationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update repository_invitations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by update for repository_invitations")
+	}
+
+	if !cached {
+		repositoryInvitationUpdateCacheMut.Lock()
+		repositoryInvitationUpdateCache[key] = cache
+		repositoryInvitationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q repositoryInvitationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all for repository_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected for repository_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RepositoryInvitationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("db: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), repositoryInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"repository_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, repositoryInvitationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to update all in repositoryInvitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to retrieve rows affected all in update all repositoryInvitation")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RepositoryInvitation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("db: no repository_invitations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(repositoryInvitationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	repositoryInvitationUpsertCacheMut.RLock()
+	cache, cached := repositoryInvitationUpsertCache[key]
+	repositoryInvitationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			repositoryInvitationAllColumns,
+			repositoryInvitationColumnsWithDefault,
+			repositoryInvitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			repositoryInvitationAllColumns,
+			repositoryInvitationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("db: unable to upsert repository_invitations, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(repositoryInvitationPrimaryKeyColumns))
+			copy(conflict, repositoryInvitationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"repository_invitations\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(repositoryInvitationType, repositoryInvitationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(repositoryInvitationType, repositoryInvitationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "db: unable to upsert repository_invitations")
+	}
+
+	if !cached {
+		repositoryInvitationUpsertCacheMut.Lock()
+		repositoryInvitationUpsertCache[key] = cache
+		repositoryInvitationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single RepositoryInvitation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RepositoryInvitation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("db: no RepositoryInvitation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), repositoryInvitationPrimaryKeyMapping)
+	sql := "DELETE FROM \"repository_invitations\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete from repository_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by delete for repository_invitations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q repositoryInvitationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("db: no repositoryInvitationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from repository_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for repository_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RepositoryInvitationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(repositoryInvitationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), repositoryInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"repository_invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, repositoryInvitationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "db: unable to delete all from repositoryInvitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "db: failed to get rows affected by deleteall for repository_invitations")
+	}
+
+	if len(repositoryInvitationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RepositoryInvitation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRepositoryInvitation(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RepositoryInvitationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RepositoryInvitationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), repositoryInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"repository_invitations\".* FROM \"repository_invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, repositoryInvitationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "db: unable to reload all in RepositoryInvitationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RepositoryInvitationExists checks if the RepositoryInvitation row exists.
+func RepositoryInvitationExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"repository_invitations\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "db: unable to check if repository_invitations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RepositoryInvitation row exists.
+func (o *RepositoryInvitation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RepositoryInvitationExists(ctx, exec, o.ID)
+}
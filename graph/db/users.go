@@ -24,62 +24,112 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	ProjectV2 null.String `boil:"project_v2" json:"project_v2,omitempty" toml:"project_v2" yaml:"project_v2,omitempty"`
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name         string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	ProjectV2    null.String `boil:"project_v2" json:"project_v2,omitempty" toml:"project_v2" yaml:"project_v2,omitempty"`
+	PasswordHash null.String `boil:"password_hash" json:"password_hash,omitempty" toml:"password_hash" yaml:"password_hash,omitempty"`
+	IsSiteAdmin  bool        `boil:"is_site_admin" json:"is_site_admin" toml:"is_site_admin" yaml:"is_site_admin"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID        string
-	Name      string
-	ProjectV2 string
+	ID           string
+	Name         string
+	ProjectV2    string
+	PasswordHash string
+	IsSiteAdmin  string
 }{
-	ID:        "id",
-	Name:      "name",
-	ProjectV2: "project_v2",
+	ID:           "id",
+	Name:         "name",
+	ProjectV2:    "project_v2",
+	PasswordHash: "password_hash",
+	IsSiteAdmin:  "is_site_admin",
 }
 
 var UserTableColumns = struct {
-	ID        string
-	Name      string
-	ProjectV2 string
+	ID           string
+	Name         string
+	ProjectV2    string
+	PasswordHash string
+	IsSiteAdmin  string
 }{
-	ID:        "users.id",
-	Name:      "users.name",
-	ProjectV2: "users.project_v2",
+	ID:           "users.id",
+	Name:         "users.name",
+	ProjectV2:    "users.project_v2",
+	PasswordHash: "users.password_hash",
+	IsSiteAdmin:  "users.is_site_admin",
 }
 
 // Generated where
 
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
 var UserWhere = struct {
-	ID        whereHelperstring
-	Name      whereHelperstring
-	ProjectV2 whereHelpernull_String
+	ID           whereHelperstring
+	Name         whereHelperstring
+	ProjectV2    whereHelpernull_String
+	PasswordHash whereHelpernull_String
+	IsSiteAdmin  whereHelperbool
 }{
-	ID:        whereHelperstring{field: "\"users\".\"id\""},
-	Name:      whereHelperstring{field: "\"users\".\"name\""},
-	ProjectV2: whereHelpernull_String{field: "\"users\".\"project_v2\""},
+	ID:           whereHelperstring{field: "\"users\".\"id\""},
+	Name:         whereHelperstring{field: "\"users\".\"name\""},
+	ProjectV2:    whereHelpernull_String{field: "\"users\".\"project_v2\""},
+	PasswordHash: whereHelpernull_String{field: "\"users\".\"password_hash\""},
+	IsSiteAdmin:  whereHelperbool{field: "\"users\".\"is_site_admin\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	AuthorIssues      string
-	OwnerProjects     string
-	OwnerRepositories string
+	CollaboratorCollaborators    string
+	TargetImpersonations         string
+	AdminImpersonations          string
+	AuthorIssues                 string
+	OwnerPersonalAccessTokens    string
+	OwnerProjects                string
+	OwnerRepositories            string
+	InviterRepositoryInvitations string
+	InviteeRepositoryInvitations string
+	OwnerSessions                string
+	BlockedUserBlocks            string
+	BlockerUserBlocks            string
 }{
-	AuthorIssues:      "AuthorIssues",
-	OwnerProjects:     "OwnerProjects",
-	OwnerRepositories: "OwnerRepositories",
+	CollaboratorCollaborators:    "CollaboratorCollaborators",
+	TargetImpersonations:         "TargetImpersonations",
+	AdminImpersonations:          "AdminImpersonations",
+	AuthorIssues:                 "AuthorIssues",
+	OwnerPersonalAccessTokens:    "OwnerPersonalAccessTokens",
+	OwnerProjects:                "OwnerProjects",
+	OwnerRepositories:            "OwnerRepositories",
+	InviterRepositoryInvitations: "InviterRepositoryInvitations",
+	InviteeRepositoryInvitations: "InviteeRepositoryInvitations",
+	OwnerSessions:                "OwnerSessions",
+	BlockedUserBlocks:            "BlockedUserBlocks",
+	BlockerUserBlocks:            "BlockerUserBlocks",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	AuthorIssues      IssueSlice      `boil:"AuthorIssues" json:"AuthorIssues" toml:"AuthorIssues" yaml:"AuthorIssues"`
-	OwnerProjects     ProjectSlice    `boil:"OwnerProjects" json:"OwnerProjects" toml:"OwnerProjects" yaml:"OwnerProjects"`
-	OwnerRepositories RepositorySlice `boil:"OwnerRepositories" json:"OwnerRepositories" toml:"OwnerRepositories" yaml:"OwnerRepositories"`
+	CollaboratorCollaborators    CollaboratorSlice         `boil:"CollaboratorCollaborators" json:"CollaboratorCollaborators" toml:"CollaboratorCollaborators" yaml:"CollaboratorCollaborators"`
+	TargetImpersonations         ImpersonationSlice        `boil:"TargetImpersonations" json:"TargetImpersonations" toml:"TargetImpersonations" yaml:"TargetImpersonations"`
+	AdminImpersonations          ImpersonationSlice        `boil:"AdminImpersonations" json:"AdminImpersonations" toml:"AdminImpersonations" yaml:"AdminImpersonations"`
+	AuthorIssues                 IssueSlice                `boil:"AuthorIssues" json:"AuthorIssues" toml:"AuthorIssues" yaml:"AuthorIssues"`
+	OwnerPersonalAccessTokens    PersonalAccessTokenSlice  `boil:"OwnerPersonalAccessTokens" json:"OwnerPersonalAccessTokens" toml:"OwnerPersonalAccessTokens" yaml:"OwnerPersonalAccessTokens"`
+	OwnerProjects                ProjectSlice              `boil:"OwnerProjects" json:"OwnerProjects" toml:"OwnerProjects" yaml:"OwnerProjects"`
+	OwnerRepositories            RepositorySlice           `boil:"OwnerRepositories" json:"OwnerRepositories" toml:"OwnerRepositories" yaml:"OwnerRepositories"`
+	InviterRepositoryInvitations RepositoryInvitationSlice `boil:"InviterRepositoryInvitations" json:"InviterRepositoryInvitations" toml:"InviterRepositoryInvitations" yaml:"InviterRepositoryInvitations"`
+	InviteeRepositoryInvitations RepositoryInvitationSlice `boil:"InviteeRepositoryInvitations" json:"InviteeRepositoryInvitations" toml:"InviteeRepositoryInvitations" yaml:"InviteeRepositoryInvitations"`
+	OwnerSessions                SessionSlice              `boil:"OwnerSessions" json:"OwnerSessions" toml:"OwnerSessions" yaml:"OwnerSessions"`
+	BlockedUserBlocks            UserBlockSlice            `boil:"BlockedUserBlocks" json:"BlockedUserBlocks" toml:"BlockedUserBlocks" yaml:"BlockedUserBlocks"`
+	BlockerUserBlocks            UserBlockSlice            `boil:"BlockerUserBlocks" json:"BlockerUserBlocks" toml:"BlockerUserBlocks" yaml:"BlockerUserBlocks"`
 }
 
 // NewStruct creates a new relationship struct
@@ -87,6 +137,27 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetCollaboratorCollaborators() CollaboratorSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CollaboratorCollaborators
+}
+
+func (r *userR) GetTargetImpersonations() ImpersonationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TargetImpersonations
+}
+
+func (r *userR) GetAdminImpersonations() ImpersonationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AdminImpersonations
+}
+
 func (r *userR) GetAuthorIssues() IssueSlice {
 	if r == nil {
 		return nil
@@ -94,6 +165,13 @@ func (r *userR) GetAuthorIssues() IssueSlice {
 	return r.AuthorIssues
 }
 
+func (r *userR) GetOwnerPersonalAccessTokens() PersonalAccessTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OwnerPersonalAccessTokens
+}
+
 func (r *userR) GetOwnerProjects() ProjectSlice {
 	if r == nil {
 		return nil
@@ -108,13 +186,48 @@ func (r *userR) GetOwnerRepositories() RepositorySlice {
 	return r.OwnerRepositories
 }
 
+func (r *userR) GetInviterRepositoryInvitations() RepositoryInvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.InviterRepositoryInvitations
+}
+
+func (r *userR) GetInviteeRepositoryInvitations() RepositoryInvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.InviteeRepositoryInvitations
+}
+
+func (r *userR) GetOwnerSessions() SessionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OwnerSessions
+}
+
+func (r *userR) GetBlockedUserBlocks() UserBlockSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BlockedUserBlocks
+}
+
+func (r *userR) GetBlockerUserBlocks() UserBlockSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BlockerUserBlocks
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "name", "project_v2"}
+	userAllColumns            = []string{"id", "name", "project_v2", "password_hash", "is_site_admin"}
 	userColumnsWithoutDefault = []string{"id", "name"}
-	userColumnsWithDefault    = []string{"project_v2"}
+	userColumnsWithDefault    = []string{"project_v2", "password_hash", "is_site_admin"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -397,6 +510,48 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// CollaboratorCollaborators retrieves all the collaborator's Collaborators with an executor via collaborator column.
+func (o *User) CollaboratorCollaborators(mods ...qm.QueryMod) collaboratorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"collaborators\".\"collaborator\"=?", o.ID),
+	)
+
+	return Collaborators(queryMods...)
+}
+
+// TargetImpersonations retrieves all the impersonation's Impersonations with an executor via target column.
+func (o *User) TargetImpersonations(mods ...qm.QueryMod) impersonationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"impersonations\".\"target\"=?", o.ID),
+	)
+
+	return Impersonations(queryMods...)
+}
+
+// AdminImpersonations retrieves all the impersonation's Impersonations with an executor via admin column.
+func (o *User) AdminImpersonations(mods ...qm.QueryMod) impersonationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"impersonations\".\"admin\"=?", o.ID),
+	)
+
+	return Impersonations(queryMods...)
+}
+
 // AuthorIssues retrieves all the issue's Issues with an executor via author column.
 func (o *User) AuthorIssues(mods ...qm.QueryMod) issueQuery {
 	var queryMods []qm.QueryMod
@@ -411,6 +566,20 @@ func (o *User) AuthorIssues(mods ...qm.QueryMod) issueQuery {
 	return Issues(queryMods...)
 }
 
+// OwnerPersonalAccessTokens retrieves all the personal_access_token's PersonalAccessTokens with an executor via owner column.
+func (o *User) OwnerPersonalAccessTokens(mods ...qm.QueryMod) personalAccessTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"personal_access_tokens\".\"owner\"=?", o.ID),
+	)
+
+	return PersonalAccessTokens(queryMods...)
+}
+
 // OwnerProjects retrieves all the project's Projects with an executor via owner column.
 func (o *User) OwnerProjects(mods ...qm.QueryMod) projectQuery {
 	var queryMods []qm.QueryMod
@@ -439,9 +608,79 @@ func (o *User) OwnerRepositories(mods ...qm.QueryMod) repositoryQuery {
 	return Repositories(queryMods...)
 }
 
-// LoadAuthorIssues allows an eager lookup of values, cached into the
+// InviterRepositoryInvitations retrieves all the repository_invitation's RepositoryInvitations with an executor via inviter column.
+func (o *User) InviterRepositoryInvitations(mods ...qm.QueryMod) repositoryInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"repository_invitations\".\"inviter\"=?", o.ID),
+	)
+
+	return RepositoryInvitations(queryMods...)
+}
+
+// InviteeRepositoryInvitations retrieves all the repository_invitation's RepositoryInvitations with an executor via invitee column.
+func (o *User) InviteeRepositoryInvitations(mods ...qm.QueryMod) repositoryInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"repository_invitations\".\"invitee\"=?", o.ID),
+	)
+
+	return RepositoryInvitations(queryMods...)
+}
+
+// OwnerSessions retrieves all the session's Sessions with an executor via owner column.
+func (o *User) OwnerSessions(mods ...qm.QueryMod) sessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sessions\".\"owner\"=?", o.ID),
+	)
+
+	return Sessions(queryMods...)
+}
+
+// BlockedUserBlocks retrieves all the user_block's UserBlocks with an executor via blocked column.
+func (o *User) BlockedUserBlocks(mods ...qm.QueryMod) userBlockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_blocks\".\"blocked\"=?", o.ID),
+	)
+
+	return UserBlocks(queryMods...)
+}
+
+// BlockerUserBlocks retrieves all the user_block's UserBlocks with an executor via blocker column.
+func (o *User) BlockerUserBlocks(mods ...qm.QueryMod) userBlockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_blocks\".\"blocker\"=?", o.ID),
+	)
+
+	return UserBlocks(queryMods...)
+}
+
+// LoadCollaboratorCollaborators allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAuthorIssues(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadCollaboratorCollaborators(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -495,8 +734,8 @@ func (userL) LoadAuthorIssues(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`issues`),
-		qm.WhereIn(`issues.author in ?`, args...),
+		qm.From(`collaborators`),
+		qm.WhereIn(`collaborators.collaborator in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -504,22 +743,22 @@ func (userL) LoadAuthorIssues(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load issues")
+		return errors.Wrap(err, "failed to eager load collaborators")
 	}
 
-	var resultSlice []*Issue
+	var resultSlice []*Collaborator
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice issues")
+		return errors.Wrap(err, "failed to bind eager loaded slice collaborators")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on issues")
+		return errors.Wrap(err, "failed to close results in eager load on collaborators")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for issues")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collaborators")
 	}
 
-	if len(issueAfterSelectHooks) != 0 {
+	if len(collaboratorAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -527,24 +766,24 @@ func (userL) LoadAuthorIssues(ctx context.Context, e boil.ContextExecutor, singu
 		}
 	}
 	if singular {
-		object.R.AuthorIssues = resultSlice
+		object.R.CollaboratorCollaborators = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &issueR{}
+				foreign.R = &collaboratorR{}
 			}
-			foreign.R.AuthorUser = object
+			foreign.R.CollaboratorUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.Author {
-				local.R.AuthorIssues = append(local.R.AuthorIssues, foreign)
+			if local.ID == foreign.Collaborator {
+				local.R.CollaboratorCollaborators = append(local.R.CollaboratorCollaborators, foreign)
 				if foreign.R == nil {
-					foreign.R = &issueR{}
+					foreign.R = &collaboratorR{}
 				}
-				foreign.R.AuthorUser = local
+				foreign.R.CollaboratorUser = local
 				break
 			}
 		}
@@ -553,9 +792,9 @@ func (userL) LoadAuthorIssues(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadOwnerProjects allows an eager lookup of values, cached into the
+// LoadTargetImpersonations allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerProjects(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadTargetImpersonations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -609,8 +848,8 @@ func (userL) LoadOwnerProjects(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`projects`),
-		qm.WhereIn(`projects.owner in ?`, args...),
+		qm.From(`impersonations`),
+		qm.WhereIn(`impersonations.target in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -618,22 +857,22 @@ func (userL) LoadOwnerProjects(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load projects")
+		return errors.Wrap(err, "failed to eager load impersonations")
 	}
 
-	var resultSlice []*Project
+	var resultSlice []*Impersonation
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice projects")
+		return errors.Wrap(err, "failed to bind eager loaded slice impersonations")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on projects")
+		return errors.Wrap(err, "failed to close results in eager load on impersonations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for projects")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for impersonations")
 	}
 
-	if len(projectAfterSelectHooks) != 0 {
+	if len(impersonationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -641,24 +880,24 @@ func (userL) LoadOwnerProjects(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.OwnerProjects = resultSlice
+		object.R.TargetImpersonations = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &projectR{}
+				foreign.R = &impersonationR{}
 			}
-			foreign.R.OwnerUser = object
+			foreign.R.TargetUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.Owner {
-				local.R.OwnerProjects = append(local.R.OwnerProjects, foreign)
+			if local.ID == foreign.Target {
+				local.R.TargetImpersonations = append(local.R.TargetImpersonations, foreign)
 				if foreign.R == nil {
-					foreign.R = &projectR{}
+					foreign.R = &impersonationR{}
 				}
-				foreign.R.OwnerUser = local
+				foreign.R.TargetUser = local
 				break
 			}
 		}
@@ -667,9 +906,9 @@ func (userL) LoadOwnerProjects(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadOwnerRepositories allows an eager lookup of values, cached into the
+// LoadAdminImpersonations allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerRepositories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAdminImpersonations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -723,8 +962,8 @@ func (userL) LoadOwnerRepositories(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`repositories`),
-		qm.WhereIn(`repositories.owner in ?`, args...),
+		qm.From(`impersonations`),
+		qm.WhereIn(`impersonations.admin in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -732,22 +971,22 @@ func (userL) LoadOwnerRepositories(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load repositories")
+		return errors.Wrap(err, "failed to eager load impersonations")
 	}
 
-	var resultSlice []*Repository
+	var resultSlice []*Impersonation
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice repositories")
+		return errors.Wrap(err, "failed to bind eager loaded slice impersonations")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on repositories")
+		return errors.Wrap(err, "failed to close results in eager load on impersonations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repositories")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for impersonations")
 	}
 
-	if len(repositoryAfterSelectHooks) != 0 {
+	if len(impersonationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -755,24 +994,24 @@ func (userL) LoadOwnerRepositories(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.OwnerRepositories = resultSlice
+		object.R.AdminImpersonations = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &repositoryR{}
+				foreign.R = &impersonationR{}
 			}
-			foreign.R.OwnerUser = object
+			foreign.R.AdminUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.Owner {
-				local.R.OwnerRepositories = append(local.R.OwnerRepositories, foreign)
+			if local.ID == foreign.Admin {
+				local.R.AdminImpersonations = append(local.R.AdminImpersonations, foreign)
 				if foreign.R == nil {
-					foreign.R = &repositoryR{}
+					foreign.R = &impersonationR{}
 				}
-				foreign.R.OwnerUser = local
+				foreign.R.AdminUser = local
 				break
 			}
 		}
@@ -781,51 +1020,1236 @@ func (userL) LoadOwnerRepositories(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// AddAuthorIssues adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.AuthorIssues.
-// Sets related.R.AuthorUser appropriately.
-func (o *User) AddAuthorIssues(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Issue) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.Author = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"issues\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 0, []string{"author"}),
-				strmangle.WhereClause("\"", "\"", 0, issuePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadAuthorIssues allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAuthorIssues(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
 			}
-
-			rel.Author = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			AuthorIssues: related,
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		o.R.AuthorIssues = append(o.R.AuthorIssues, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &issueR{
-				AuthorUser: o,
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`issues`),
+		qm.WhereIn(`issues.author in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load issues")
+	}
+
+	var resultSlice []*Issue
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice issues")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on issues")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for issues")
+	}
+
+	if len(issueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AuthorIssues = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &issueR{}
+			}
+			foreign.R.AuthorUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Author {
+				local.R.AuthorIssues = append(local.R.AuthorIssues, foreign)
+				if foreign.R == nil {
+					foreign.R = &issueR{}
+				}
+				foreign.R.AuthorUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerPersonalAccessTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerPersonalAccessTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`personal_access_tokens`),
+		qm.WhereIn(`personal_access_tokens.owner in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load personal_access_tokens")
+	}
+
+	var resultSlice []*PersonalAccessToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice personal_access_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on personal_access_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for personal_access_tokens")
+	}
+
+	if len(personalAccessTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerPersonalAccessTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &personalAccessTokenR{}
+			}
+			foreign.R.OwnerUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Owner {
+				local.R.OwnerPersonalAccessTokens = append(local.R.OwnerPersonalAccessTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &personalAccessTokenR{}
+				}
+				foreign.R.OwnerUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerProjects allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerProjects(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`projects`),
+		qm.WhereIn(`projects.owner in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load projects")
+	}
+
+	var resultSlice []*Project
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice projects")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on projects")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for projects")
+	}
+
+	if len(projectAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerProjects = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &projectR{}
+			}
+			foreign.R.OwnerUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Owner {
+				local.R.OwnerProjects = append(local.R.OwnerProjects, foreign)
+				if foreign.R == nil {
+					foreign.R = &projectR{}
+				}
+				foreign.R.OwnerUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerRepositories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerRepositories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`repositories`),
+		qm.WhereIn(`repositories.owner in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load repositories")
+	}
+
+	var resultSlice []*Repository
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice repositories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on repositories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repositories")
+	}
+
+	if len(repositoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerRepositories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &repositoryR{}
+			}
+			foreign.R.OwnerUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Owner {
+				local.R.OwnerRepositories = append(local.R.OwnerRepositories, foreign)
+				if foreign.R == nil {
+					foreign.R = &repositoryR{}
+				}
+				foreign.R.OwnerUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInviterRepositoryInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInviterRepositoryInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`repository_invitations`),
+		qm.WhereIn(`repository_invitations.inviter in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load repository_invitations")
+	}
+
+	var resultSlice []*RepositoryInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice repository_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on repository_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repository_invitations")
+	}
+
+	if len(repositoryInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.InviterRepositoryInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &repositoryInvitationR{}
+			}
+			foreign.R.InviterUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Inviter {
+				local.R.InviterRepositoryInvitations = append(local.R.InviterRepositoryInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &repositoryInvitationR{}
+				}
+				foreign.R.InviterUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInviteeRepositoryInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInviteeRepositoryInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`repository_invitations`),
+		qm.WhereIn(`repository_invitations.invitee in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load repository_invitations")
+	}
+
+	var resultSlice []*RepositoryInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice repository_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on repository_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for repository_invitations")
+	}
+
+	if len(repositoryInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.InviteeRepositoryInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &repositoryInvitationR{}
+			}
+			foreign.R.InviteeUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Invitee {
+				local.R.InviteeRepositoryInvitations = append(local.R.InviteeRepositoryInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &repositoryInvitationR{}
+				}
+				foreign.R.InviteeUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`sessions`),
+		qm.WhereIn(`sessions.owner in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sessions")
+	}
+
+	var resultSlice []*Session
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sessions")
+	}
+
+	if len(sessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &sessionR{}
+			}
+			foreign.R.OwnerUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Owner {
+				local.R.OwnerSessions = append(local.R.OwnerSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &sessionR{}
+				}
+				foreign.R.OwnerUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBlockedUserBlocks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBlockedUserBlocks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_blocks`),
+		qm.WhereIn(`user_blocks.blocked in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_blocks")
+	}
+
+	var resultSlice []*UserBlock
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_blocks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_blocks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_blocks")
+	}
+
+	if len(userBlockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BlockedUserBlocks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userBlockR{}
+			}
+			foreign.R.BlockedUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Blocked {
+				local.R.BlockedUserBlocks = append(local.R.BlockedUserBlocks, foreign)
+				if foreign.R == nil {
+					foreign.R = &userBlockR{}
+				}
+				foreign.R.BlockedUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBlockerUserBlocks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBlockerUserBlocks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_blocks`),
+		qm.WhereIn(`user_blocks.blocker in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_blocks")
+	}
+
+	var resultSlice []*UserBlock
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_blocks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_blocks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_blocks")
+	}
+
+	if len(userBlockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BlockerUserBlocks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userBlockR{}
+			}
+			foreign.R.BlockerUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Blocker {
+				local.R.BlockerUserBlocks = append(local.R.BlockerUserBlocks, foreign)
+				if foreign.R == nil {
+					foreign.R = &userBlockR{}
+				}
+				foreign.R.BlockerUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddCollaboratorCollaborators adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CollaboratorCollaborators.
+// Sets related.R.CollaboratorUser appropriately.
+func (o *User) AddCollaboratorCollaborators(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Collaborator) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Collaborator = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"collaborators\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"collaborator"}),
+				strmangle.WhereClause("\"", "\"", 0, collaboratorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Repository, rel.Collaborator}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Collaborator = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CollaboratorCollaborators: related,
+		}
+	} else {
+		o.R.CollaboratorCollaborators = append(o.R.CollaboratorCollaborators, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collaboratorR{
+				CollaboratorUser: o,
+			}
+		} else {
+			rel.R.CollaboratorUser = o
+		}
+	}
+	return nil
+}
+
+// AddTargetImpersonations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TargetImpersonations.
+// Sets related.R.TargetUser appropriately.
+func (o *User) AddTargetImpersonations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Impersonation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Target = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"impersonations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"target"}),
+				strmangle.WhereClause("\"", "\"", 0, impersonationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Target = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TargetImpersonations: related,
+		}
+	} else {
+		o.R.TargetImpersonations = append(o.R.TargetImpersonations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &impersonationR{
+				TargetUser: o,
+			}
+		} else {
+			rel.R.TargetUser = o
+		}
+	}
+	return nil
+}
+
+// AddAdminImpersonations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AdminImpersonations.
+// Sets related.R.AdminUser appropriately.
+func (o *User) AddAdminImpersonations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Impersonation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Admin = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"impersonations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"admin"}),
+				strmangle.WhereClause("\"", "\"", 0, impersonationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Admin = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AdminImpersonations: related,
+		}
+	} else {
+		o.R.AdminImpersonations = append(o.R.AdminImpersonations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &impersonationR{
+				AdminUser: o,
+			}
+		} else {
+			rel.R.AdminUser = o
+		}
+	}
+	return nil
+}
+
+// AddAuthorIssues adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorIssues.
+// Sets related.R.AuthorUser appropriately.
+func (o *User) AddAuthorIssues(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Issue) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Author = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"issues\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"author"}),
+				strmangle.WhereClause("\"", "\"", 0, issuePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Author = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AuthorIssues: related,
+		}
+	} else {
+		o.R.AuthorIssues = append(o.R.AuthorIssues, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &issueR{
+				AuthorUser: o,
 			}
 		} else {
 			rel.R.AuthorUser = o
@@ -834,6 +2258,59 @@ func (o *User) AddAuthorIssues(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
+// AddOwnerPersonalAccessTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerPersonalAccessTokens.
+// Sets related.R.OwnerUser appropriately.
+func (o *User) AddOwnerPersonalAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PersonalAccessToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Owner = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"personal_access_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"owner"}),
+				strmangle.WhereClause("\"", "\"", 0, personalAccessTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Owner = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerPersonalAccessTokens: related,
+		}
+	} else {
+		o.R.OwnerPersonalAccessTokens = append(o.R.OwnerPersonalAccessTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &personalAccessTokenR{
+				OwnerUser: o,
+			}
+		} else {
+			rel.R.OwnerUser = o
+		}
+	}
+	return nil
+}
+
 // AddOwnerProjects adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.OwnerProjects.
@@ -940,6 +2417,271 @@ func (o *User) AddOwnerRepositories(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
+// AddInviterRepositoryInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InviterRepositoryInvitations.
+// Sets related.R.InviterUser appropriately.
+func (o *User) AddInviterRepositoryInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RepositoryInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Inviter = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"repository_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"inviter"}),
+				strmangle.WhereClause("\"", "\"", 0, repositoryInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Inviter = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			InviterRepositoryInvitations: related,
+		}
+	} else {
+		o.R.InviterRepositoryInvitations = append(o.R.InviterRepositoryInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &repositoryInvitationR{
+				InviterUser: o,
+			}
+		} else {
+			rel.R.InviterUser = o
+		}
+	}
+	return nil
+}
+
+// AddInviteeRepositoryInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InviteeRepositoryInvitations.
+// Sets related.R.InviteeUser appropriately.
+func (o *User) AddInviteeRepositoryInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RepositoryInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Invitee = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"repository_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"invitee"}),
+				strmangle.WhereClause("\"", "\"", 0, repositoryInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Invitee = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			InviteeRepositoryInvitations: related,
+		}
+	} else {
+		o.R.InviteeRepositoryInvitations = append(o.R.InviteeRepositoryInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &repositoryInvitationR{
+				InviteeUser: o,
+			}
+		} else {
+			rel.R.InviteeUser = o
+		}
+	}
+	return nil
+}
+
+// AddOwnerSessions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerSessions.
+// Sets related.R.OwnerUser appropriately.
+func (o *User) AddOwnerSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Session) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Owner = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"sessions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"owner"}),
+				strmangle.WhereClause("\"", "\"", 0, sessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Owner = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerSessions: related,
+		}
+	} else {
+		o.R.OwnerSessions = append(o.R.OwnerSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &sessionR{
+				OwnerUser: o,
+			}
+		} else {
+			rel.R.OwnerUser = o
+		}
+	}
+	return nil
+}
+
+// AddBlockedUserBlocks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BlockedUserBlocks.
+// Sets related.R.BlockedUser appropriately.
+func (o *User) AddBlockedUserBlocks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserBlock) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Blocked = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_blocks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"blocked"}),
+				strmangle.WhereClause("\"", "\"", 0, userBlockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Blocker, rel.Blocked}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Blocked = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BlockedUserBlocks: related,
+		}
+	} else {
+		o.R.BlockedUserBlocks = append(o.R.BlockedUserBlocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userBlockR{
+				BlockedUser: o,
+			}
+		} else {
+			rel.R.BlockedUser = o
+		}
+	}
+	return nil
+}
+
+// AddBlockerUserBlocks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BlockerUserBlocks.
+// Sets related.R.BlockerUser appropriately.
+func (o *User) AddBlockerUserBlocks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserBlock) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Blocker = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_blocks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"blocker"}),
+				strmangle.WhereClause("\"", "\"", 0, userBlockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Blocker, rel.Blocked}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Blocker = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BlockerUserBlocks: related,
+		}
+	} else {
+		o.R.BlockerUserBlocks = append(o.R.BlockerUserBlocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userBlockR{
+				BlockerUser: o,
+			}
+		} else {
+			rel.R.BlockerUser = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
@@ -2,20 +2,60 @@ package graph
 
 import (
 	"context"
-	"errors"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
 	"github.com/saki-engineering/graphql-sample/internal"
 	"github.com/saki-engineering/graphql-sample/middlewares/auth"
 )
 
 var Directive internal.DirectiveRoot = internal.DirectiveRoot{
+	Auth:            Auth,
 	IsAuthenticated: IsAuthenticated,
+	RequiresScope:   RequiresScope,
 }
 
 func IsAuthenticated(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error) {
 	if _, ok := auth.GetUserName(ctx); !ok {
-		return nil, errors.New("not authenticated")
+		return nil, apperrors.New(apperrors.Forbidden, "not authenticated")
+	}
+	return next(ctx)
+}
+
+// RequiresScope は、認証に使われたトークンがscopesを全て持っている場合だけ次に進む
+// 足りない場合は、どのスコープが足りないのかをエラーで返す
+func RequiresScope(ctx context.Context, obj interface{}, next graphql.Resolver, scopes []string) (res interface{}, err error) {
+	granted, ok := auth.GetScopes(ctx)
+	if !ok {
+		return nil, apperrors.New(apperrors.Forbidden, "not authenticated")
+	}
+	for _, s := range scopes {
+		if !granted.Has(scope.Scope(s)) {
+			return nil, apperrors.New(apperrors.Forbidden, "token is missing the %q scope", s)
+		}
+	}
+	return next(ctx)
+}
+
+// Auth は、policyのルールが登録されていて、閲覧者がログインしている場合だけ次に進む
+// ログインせずに行える操作は、ログインしていなくても次に進む
+// 閲覧者だけで判定できるルールはここで判定し、対象のリソースが必要なルールの残りはservicesで判定する
+func Auth(ctx context.Context, obj interface{}, next graphql.Resolver, policyArg string) (res interface{}, err error) {
+	action := policy.Action(policyArg)
+	if _, ok := policy.Lookup(action); !ok {
+		return nil, apperrors.New(apperrors.Internal, "unknown policy %q", policyArg)
+	}
+	viewer, ok := auth.GetUser(ctx)
+	if policy.IsViewerOnly(action) {
+		if err := policy.Authorize(action, policy.Facts{Viewer: viewer}); err != nil {
+			return nil, err
+		}
+		return next(ctx)
+	}
+	if !ok && !policy.AllowsAnonymous(action) {
+		return nil, apperrors.New(apperrors.Forbidden, "not authenticated")
 	}
 	return next(ctx)
 }
@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"context"
+	"log"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrorPresenter は、リゾルバが返したエラーにextensions.codeを付けてクライアントに返す
+// 内部エラーの詳細は相関IDと一緒にサーバーのログにだけ出力する
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	// 既にコードが付いているもの、GraphQLのレイヤーで作られたものはそのまま返す
+	if _, ok := gqlErr.Extensions["code"]; ok {
+		return gqlErr
+	}
+	cause := gqlErr.Unwrap()
+	if cause == nil {
+		return gqlErr
+	}
+
+	appErr := apperrors.From(cause)
+	extensions := map[string]interface{}{
+		"code": appErr.Code,
+	}
+	if appErr.Code == apperrors.Internal {
+		correlationID := uuid.New().String()
+		log.Printf("internal error (correlationId=%s, path=%s): %+v", correlationID, gqlErr.Path, cause)
+		extensions["correlationId"] = correlationID
+	}
+
+	return &gqlerror.Error{
+		Message:    appErr.Message,
+		Path:       gqlErr.Path,
+		Locations:  gqlErr.Locations,
+		Extensions: extensions,
+	}
+}
+
+// userErrors は、クライアントの入力が原因のエラーをペイロードのuserErrorsに変換する
+// pathにはエラーの原因になった入力フィールドを指定する
+// 内部エラーの場合はトップレベルのエラーとして返すため、そのままerrを返す
+func userErrors(err error, path ...string) ([]*model.UserError, error) {
+	appErr := apperrors.From(err)
+	if appErr.Code == apperrors.Internal {
+		return nil, err
+	}
+	return []*model.UserError{
+		{
+			Message: appErr.Message,
+			Path:    path,
+			Code:    model.UserErrorCode(appErr.Code),
+		},
+	}, nil
+}
+
+// nullIfNotFound は、見つからなかった場合にエラーではなくnullを返すためのもの
+func nullIfNotFound[T any](v T, err error) (T, error) {
+	if apperrors.IsNotFound(err) {
+		var zero T
+		return zero, nil
+	}
+	return v, err
+}
@@ -0,0 +1,83 @@
+// Package globalid は、Nodeインターフェースを実装する型のグローバルIDを扱う
+//
+// グローバルIDは "<型のprefix>_<キー>" という形式の文字列で、
+// prefixからどの型のノードを指しているかを判別できる
+package globalid
+
+import (
+	"strings"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+)
+
+// Type は、グローバルIDのprefixで表されるノードの型
+type Type string
+
+const (
+	User          Type = "U"
+	Repository    Type = "REPO"
+	Issue         Type = "ISSUE"
+	PullRequest   Type = "PR"
+	ProjectV2     Type = "PJ"
+	ProjectV2Item Type = "PJI"
+	// RepositoryInvitation は、招待されたユーザーとリポジトリのADMINだけが参照できる
+	RepositoryInvitation Type = "RINV"
+	// PersonalAccessToken は、発行したユーザー本人だけが参照できる
+	PersonalAccessToken Type = "PAT"
+	// AuditLogEntry は、サイトの管理者だけが参照できる
+	AuditLogEntry Type = "AUDIT"
+)
+
+const separator = "_"
+
+// Types は、グローバルIDを持つ全ての型
+var Types = []Type{User, Repository, Issue, PullRequest, ProjectV2, ProjectV2Item, RepositoryInvitation, PersonalAccessToken, AuditLogEntry}
+
+func (t Type) valid() bool {
+	for _, v := range Types {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+type ID struct {
+	Type Type
+	Key  string
+}
+
+func (id ID) String() string {
+	return Encode(id.Type, id.Key)
+}
+
+// Encode は、型とキーからグローバルIDを作る
+func Encode(t Type, key string) string {
+	return string(t) + separator + key
+}
+
+// Decode は、グローバルIDを型とキーに分解する
+func Decode(id string) (ID, error) {
+	t, key, ok := strings.Cut(id, separator)
+	if !ok || key == "" {
+		return ID{}, apperrors.New(apperrors.Validation, "invalid ID %q", id)
+	}
+	if !Type(t).valid() {
+		return ID{}, apperrors.New(apperrors.Validation, "invalid ID %q: unknown type %q", id, t)
+	}
+	return ID{Type: Type(t), Key: key}, nil
+}
+
+// DecodeAs は、グローバルIDを分解した上で、想定しているいずれかの型であることを確かめる
+func DecodeAs(id string, types ...Type) (ID, error) {
+	gid, err := Decode(id)
+	if err != nil {
+		return ID{}, err
+	}
+	for _, t := range types {
+		if gid.Type == t {
+			return gid, nil
+		}
+	}
+	return ID{}, apperrors.New(apperrors.Validation, "invalid ID %q: unexpected type %q", id, gid.Type)
+}
@@ -0,0 +1,86 @@
+package globalid_test
+
+import (
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		title    string
+		id       string
+		expected globalid.ID
+		wantErr  bool
+	}{
+		{
+			title:    "user",
+			id:       "U_1",
+			expected: globalid.ID{Type: globalid.User, Key: "1"},
+		},
+		{
+			title:    "key with separator",
+			id:       "PJI_ab_cd",
+			expected: globalid.ID{Type: globalid.ProjectV2Item, Key: "ab_cd"},
+		},
+		{
+			title:   "no separator",
+			id:      "REPO1",
+			wantErr: true,
+		},
+		{
+			title:   "empty key",
+			id:      "ISSUE_",
+			wantErr: true,
+		},
+		{
+			title:   "unknown type",
+			id:      "FOO_1",
+			wantErr: true,
+		},
+		{
+			title:   "empty",
+			id:      "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			got, err := globalid.Decode(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decode(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("Decode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for _, typ := range globalid.Types {
+		id := globalid.Encode(typ, "key_1")
+		got, err := globalid.Decode(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(globalid.ID{Type: typ, Key: "key_1"}, got); diff != "" {
+			t.Errorf("Decode(Encode()) mismatch (-want +got):\n%s", diff)
+		}
+		if got.String() != id {
+			t.Errorf("String() = %q, want %q", got.String(), id)
+		}
+	}
+}
+
+func TestDecodeAs(t *testing.T) {
+	if _, err := globalid.DecodeAs("ISSUE_1", globalid.Issue, globalid.PullRequest); err != nil {
+		t.Error(err)
+	}
+	if _, err := globalid.DecodeAs("PJ_1", globalid.Issue, globalid.PullRequest); err == nil {
+		t.Error("PJ_1 should not be accepted as Issue or PullRequest")
+	}
+}
@@ -0,0 +1,85 @@
+// Package impersonation は、管理者が他のユーザーになりすましたオペレーションを記録するgqlgenの拡張を提供する
+// なりすましはサポートのために利用者の見ているものを再現するためのものなので、既定ではmutationを拒否する
+package impersonation
+
+import (
+	"context"
+	"log"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const extensionName = "Impersonation"
+
+// Recorder は、なりすましで実行されたオペレーションを記録する
+type Recorder interface {
+	RecordImpersonation(ctx context.Context, adminID, targetID, operationName string) error
+}
+
+type Extension struct {
+	recorder       Recorder
+	allowMutations bool
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &Extension{}
+
+type Option func(*Extension)
+
+// AllowMutations は、なりすましている間もmutationを実行できるようにする
+func AllowMutations() Option {
+	return func(e *Extension) {
+		e.allowMutations = true
+	}
+}
+
+func New(recorder Recorder, opts ...Option) *Extension {
+	e := &Extension{recorder: recorder}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+func (e *Extension) ExtensionName() string {
+	return extensionName
+}
+
+func (e *Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext は、なりすましているオペレーションを記録してから実行させる
+// 記録できなかった場合と、許可されていないmutationは実行しない
+func (e *Extension) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	admin, ok := auth.GetImpersonator(ctx)
+	if !ok {
+		return nil
+	}
+	target, _ := auth.GetUser(ctx)
+
+	op := opCtx.Operation
+	if err := e.recorder.RecordImpersonation(ctx, admin.ID, target.ID, op.Name); err != nil {
+		log.Printf("failed to record impersonation (admin=%s, target=%s): %v", admin.ID, target.ID, err)
+		return withCode(gqlerror.Errorf("failed to record impersonation"), apperrors.Internal)
+	}
+
+	if op.Operation == ast.Mutation && !e.allowMutations {
+		return withCode(gqlerror.Errorf("mutations are not allowed while impersonating %s", target.Name), apperrors.Forbidden)
+	}
+	return nil
+}
+
+func withCode(err *gqlerror.Error, code apperrors.Code) *gqlerror.Error {
+	err.Extensions = map[string]interface{}{
+		"code": code,
+	}
+	return err
+}
@@ -0,0 +1,120 @@
+package impersonation_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/impersonation"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type record struct {
+	adminID, targetID, operationName string
+}
+
+type recorder struct {
+	records []record
+	err     error
+}
+
+func (r *recorder) RecordImpersonation(ctx context.Context, adminID, targetID, operationName string) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.records = append(r.records, record{adminID, targetID, operationName})
+	return nil
+}
+
+type adminToken struct{}
+
+func (adminToken) AuthenticateToken(ctx context.Context, token string) (*model.User, scope.Set, error) {
+	return &model.User{ID: "U_1", Name: "hsaki", IsSiteAdmin: true}, scope.All, nil
+}
+
+type impersonator struct{}
+
+func (impersonator) ImpersonateUser(ctx context.Context, name string) (*model.User, error) {
+	return &model.User{ID: "U_2", Name: name}, nil
+}
+
+// impersonating は、auth.AuthMiddlewareを通して、U_1の管理者がU_2になりすましているctxを作る
+func impersonating(t *testing.T) context.Context {
+	t.Helper()
+	var ctx context.Context
+	next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx = req.Context()
+	})
+	req := httptest.NewRequest(http.MethodPost, "/query", nil)
+	req.Header.Set("Authorization", "Bearer admin-token")
+	req.Header.Set(auth.ImpersonateHeader, "octocat")
+	auth.AuthMiddleware(adminToken{}, next, auth.WithImpersonation(impersonator{})).ServeHTTP(httptest.NewRecorder(), req)
+	if ctx == nil {
+		t.Fatal("impersonation was rejected")
+	}
+	return ctx
+}
+
+func operation(op ast.Operation, name string) *graphql.OperationContext {
+	return &graphql.OperationContext{Operation: &ast.OperationDefinition{Operation: op, Name: name}}
+}
+
+func TestRecordsImpersonatedOperations(t *testing.T) {
+	r := &recorder{}
+	ext := impersonation.New(r)
+	ctx := impersonating(t)
+
+	if err := ext.MutateOperationContext(ctx, operation(ast.Query, "GetRepository")); err != nil {
+		t.Fatalf("query should be allowed: %v", err)
+	}
+	err := ext.MutateOperationContext(ctx, operation(ast.Mutation, "CreateIssue"))
+	if err == nil || err.Extensions["code"] != apperrors.Forbidden {
+		t.Errorf("mutation should be forbidden, but got %v", err)
+	}
+
+	// 拒否したmutationも記録する
+	want := []record{{"U_1", "U_2", "GetRepository"}, {"U_1", "U_2", "CreateIssue"}}
+	if len(r.records) != len(want) {
+		t.Fatalf("want %d records, but got %+v", len(want), r.records)
+	}
+	for i := range want {
+		if r.records[i] != want[i] {
+			t.Errorf("record %d: want %+v, but got %+v", i, want[i], r.records[i])
+		}
+	}
+}
+
+func TestAllowMutations(t *testing.T) {
+	ext := impersonation.New(&recorder{}, impersonation.AllowMutations())
+	if err := ext.MutateOperationContext(impersonating(t), operation(ast.Mutation, "CreateIssue")); err != nil {
+		t.Errorf("mutation should be allowed: %v", err)
+	}
+}
+
+func TestNotImpersonating(t *testing.T) {
+	r := &recorder{}
+	ext := impersonation.New(r)
+	ctx := auth.WithUser(context.Background(), &model.User{ID: "U_1", IsSiteAdmin: true}, scope.All)
+	if err := ext.MutateOperationContext(ctx, operation(ast.Mutation, "CreateIssue")); err != nil {
+		t.Errorf("mutation should be allowed: %v", err)
+	}
+	if len(r.records) != 0 {
+		t.Errorf("unexpected records: %+v", r.records)
+	}
+}
+
+func TestFailsClosedWhenRecordingFails(t *testing.T) {
+	ext := impersonation.New(&recorder{err: errors.New("disk full")})
+	err := ext.MutateOperationContext(impersonating(t), operation(ast.Query, "GetRepository"))
+	if err == nil || err.Extensions["code"] != apperrors.Internal {
+		t.Errorf("want internal error, but got %v", err)
+	}
+}
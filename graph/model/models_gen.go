@@ -3,7 +3,10 @@
 package model
 
 import (
+	"fmt"
+	"io"
 	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -16,13 +19,155 @@ type ProjectV2ItemContent interface {
 	IsProjectV2ItemContent()
 }
 
+type AcceptRepositoryInvitationInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	InvitationID     string  `json:"invitationId"`
+}
+
+type AcceptRepositoryInvitationPayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	Repository       *Repository  `json:"repository,omitempty"`
+	UserErrors       []*UserError `json:"userErrors"`
+}
+
 type AddProjectV2ItemByIDInput struct {
-	ContentID string `json:"contentId"`
-	ProjectID string `json:"projectId"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ContentID        string  `json:"contentId"`
+	ProjectID        string  `json:"projectId"`
 }
 
 type AddProjectV2ItemByIDPayload struct {
-	Item *ProjectV2Item `json:"item"`
+	ClientMutationID *string        `json:"clientMutationId,omitempty"`
+	Item             *ProjectV2Item `json:"item,omitempty"`
+	UserErrors       []*UserError   `json:"userErrors"`
+}
+
+type ArchiveProjectV2ItemInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ItemID           string  `json:"itemId"`
+	ProjectID        string  `json:"projectId"`
+}
+
+type ArchiveProjectV2ItemPayload struct {
+	ClientMutationID *string        `json:"clientMutationId,omitempty"`
+	Item             *ProjectV2Item `json:"item,omitempty"`
+	UserErrors       []*UserError   `json:"userErrors"`
+}
+
+type AuditLogEntry struct {
+	ID string `json:"id"`
+	// 実行したユーザー。ログインせずに実行した場合はnull
+	Actor *User `json:"actor,omitempty"`
+	// なりすましで実行した場合の管理者
+	Impersonator  *User   `json:"impersonator,omitempty"`
+	OperationName *string `json:"operationName,omitempty"`
+	// オペレーションの変数をJSONにしたもの。パスワードやトークンなどの値は伏せてある
+	Variables string `json:"variables"`
+	// 変数と結果に含まれていたノードのID
+	NodeIds    []string        `json:"nodeIds"`
+	Outcome    AuditLogOutcome `json:"outcome"`
+	DurationMs int             `json:"durationMs"`
+	CreatedAt  time.Time       `json:"createdAt"`
+}
+
+func (AuditLogEntry) IsNode()            {}
+func (this AuditLogEntry) GetID() string { return this.ID }
+
+type AuditLogEntryConnection struct {
+	Edges      []*AuditLogEntryEdge `json:"edges,omitempty"`
+	Nodes      []*AuditLogEntry     `json:"nodes,omitempty"`
+	PageInfo   *PageInfo            `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+type AuditLogEntryEdge struct {
+	Cursor string         `json:"cursor"`
+	Node   *AuditLogEntry `json:"node,omitempty"`
+}
+
+type AuditLogFilter struct {
+	ActorID       *string `json:"actorId,omitempty"`
+	OperationName *string `json:"operationName,omitempty"`
+	// 変数か結果にこのIDを含むものだけを返す
+	NodeID  *string          `json:"nodeId,omitempty"`
+	Outcome *AuditLogOutcome `json:"outcome,omitempty"`
+	Since   *time.Time       `json:"since,omitempty"`
+	Until   *time.Time       `json:"until,omitempty"`
+}
+
+type BlockUserInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	UserID           string  `json:"userId"`
+}
+
+type BlockUserPayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	User             *User        `json:"user,omitempty"`
+	UserErrors       []*UserError `json:"userErrors"`
+}
+
+type CreateIssueInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	RepositoryID     string  `json:"repositoryId"`
+	Title            string  `json:"title"`
+}
+
+type CreateIssuePayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	Issue            *Issue       `json:"issue,omitempty"`
+	UserErrors       []*UserError `json:"userErrors"`
+}
+
+type CreatePersonalAccessTokenInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Name             string  `json:"name"`
+	// repo:read, repo:write, project, userのいずれか。repo:writeはrepo:readを含む
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type CreatePersonalAccessTokenPayload struct {
+	ClientMutationID    *string              `json:"clientMutationId,omitempty"`
+	PersonalAccessToken *PersonalAccessToken `json:"personalAccessToken,omitempty"`
+	// 生成したトークン。ハッシュだけを保存するので、この応答以外では取得できない
+	Token      *string      `json:"token,omitempty"`
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type DeclineRepositoryInvitationInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	InvitationID     string  `json:"invitationId"`
+}
+
+type DeclineRepositoryInvitationPayload struct {
+	ClientMutationID     *string      `json:"clientMutationId,omitempty"`
+	DeclinedInvitationID *string      `json:"declinedInvitationId,omitempty"`
+	UserErrors           []*UserError `json:"userErrors"`
+}
+
+type DeleteProjectV2ItemInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ItemID           string  `json:"itemId"`
+	ProjectID        string  `json:"projectId"`
+}
+
+type DeleteProjectV2ItemPayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	DeletedItemID    *string      `json:"deletedItemId,omitempty"`
+	UserErrors       []*UserError `json:"userErrors"`
+}
+
+type InviteRepositoryCollaboratorInput struct {
+	ClientMutationID *string              `json:"clientMutationId,omitempty"`
+	RepositoryID     string               `json:"repositoryId"`
+	InviteeID        string               `json:"inviteeId"`
+	Permission       RepositoryPermission `json:"permission"`
+}
+
+type InviteRepositoryCollaboratorPayload struct {
+	ClientMutationID *string               `json:"clientMutationId,omitempty"`
+	Invitation       *RepositoryInvitation `json:"invitation,omitempty"`
+	UserErrors       []*UserError          `json:"userErrors"`
 }
 
 type Issue struct {
@@ -41,25 +186,71 @@ func (this Issue) GetID() string { return this.ID }
 
 func (Issue) IsProjectV2ItemContent() {}
 
+type IssueChangedEvent struct {
+	Action        ChangeAction `json:"action"`
+	ChangedFields []string     `json:"changedFields"`
+	Issue         *Issue       `json:"issue"`
+}
+
 type IssueConnection struct {
-	Edges      []*IssueEdge `json:"edges"`
-	Nodes      []*Issue     `json:"nodes"`
+	Edges      []*IssueEdge `json:"edges,omitempty"`
+	Nodes      []*Issue     `json:"nodes,omitempty"`
 	PageInfo   *PageInfo    `json:"pageInfo"`
 	TotalCount int          `json:"totalCount"`
 }
 
 type IssueEdge struct {
 	Cursor string `json:"cursor"`
-	Node   *Issue `json:"node"`
+	Node   *Issue `json:"node,omitempty"`
+}
+
+type LoginInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Name             string  `json:"name"`
+	Password         string  `json:"password"`
+}
+
+// ログインに成功した場合は、セッションのCookieを返す
+type LoginPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	User             *User   `json:"user,omitempty"`
+	// Cookieで認証するPOSTのX-CSRF-Tokenヘッダに入れるトークン
+	CsrfToken  *string      `json:"csrfToken,omitempty"`
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type LogoutInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+type LogoutPayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	UserErrors       []*UserError `json:"userErrors"`
+}
+
+type Mutation struct {
 }
 
 type PageInfo struct {
-	EndCursor       *string `json:"endCursor"`
+	EndCursor       *string `json:"endCursor,omitempty"`
 	HasNextPage     bool    `json:"hasNextPage"`
 	HasPreviousPage bool    `json:"hasPreviousPage"`
-	StartCursor     *string `json:"startCursor"`
+	StartCursor     *string `json:"startCursor,omitempty"`
 }
 
+type PersonalAccessToken struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+}
+
+func (PersonalAccessToken) IsNode()            {}
+func (this PersonalAccessToken) GetID() string { return this.ID }
+
 type ProjectV2 struct {
 	ID     string                   `json:"id"`
 	Title  string                   `json:"title"`
@@ -73,36 +264,37 @@ func (ProjectV2) IsNode()            {}
 func (this ProjectV2) GetID() string { return this.ID }
 
 type ProjectV2Connection struct {
-	Edges      []*ProjectV2Edge `json:"edges"`
-	Nodes      []*ProjectV2     `json:"nodes"`
+	Edges      []*ProjectV2Edge `json:"edges,omitempty"`
+	Nodes      []*ProjectV2     `json:"nodes,omitempty"`
 	PageInfo   *PageInfo        `json:"pageInfo"`
 	TotalCount int              `json:"totalCount"`
 }
 
 type ProjectV2Edge struct {
 	Cursor string     `json:"cursor"`
-	Node   *ProjectV2 `json:"node"`
+	Node   *ProjectV2 `json:"node,omitempty"`
 }
 
 type ProjectV2Item struct {
-	ID      string               `json:"id"`
-	Project *ProjectV2           `json:"project"`
-	Content ProjectV2ItemContent `json:"content"`
+	ID         string               `json:"id"`
+	Project    *ProjectV2           `json:"project"`
+	Content    ProjectV2ItemContent `json:"content,omitempty"`
+	IsArchived bool                 `json:"isArchived"`
 }
 
 func (ProjectV2Item) IsNode()            {}
 func (this ProjectV2Item) GetID() string { return this.ID }
 
 type ProjectV2ItemConnection struct {
-	Edges      []*ProjectV2ItemEdge `json:"edges"`
-	Nodes      []*ProjectV2Item     `json:"nodes"`
+	Edges      []*ProjectV2ItemEdge `json:"edges,omitempty"`
+	Nodes      []*ProjectV2Item     `json:"nodes,omitempty"`
 	PageInfo   *PageInfo            `json:"pageInfo"`
 	TotalCount int                  `json:"totalCount"`
 }
 
 type ProjectV2ItemEdge struct {
 	Cursor string         `json:"cursor"`
-	Node   *ProjectV2Item `json:"node"`
+	Node   *ProjectV2Item `json:"node,omitempty"`
 }
 
 type PullRequest struct {
@@ -121,38 +313,493 @@ func (this PullRequest) GetID() string { return this.ID }
 
 func (PullRequest) IsProjectV2ItemContent() {}
 
+type PullRequestChangedEvent struct {
+	Action        ChangeAction `json:"action"`
+	ChangedFields []string     `json:"changedFields"`
+	PullRequest   *PullRequest `json:"pullRequest"`
+}
+
 type PullRequestConnection struct {
-	Edges      []*PullRequestEdge `json:"edges"`
-	Nodes      []*PullRequest     `json:"nodes"`
+	Edges      []*PullRequestEdge `json:"edges,omitempty"`
+	Nodes      []*PullRequest     `json:"nodes,omitempty"`
 	PageInfo   *PageInfo          `json:"pageInfo"`
 	TotalCount int                `json:"totalCount"`
 }
 
 type PullRequestEdge struct {
 	Cursor string       `json:"cursor"`
-	Node   *PullRequest `json:"node"`
+	Node   *PullRequest `json:"node,omitempty"`
+}
+
+type Query struct {
+}
+
+type RateLimit struct {
+	Cost      int       `json:"cost"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	ResetAt   time.Time `json:"resetAt"`
 }
 
 type Repository struct {
-	ID           string                 `json:"id"`
-	Owner        *User                  `json:"owner"`
-	Name         string                 `json:"name"`
-	CreatedAt    time.Time              `json:"createdAt"`
-	Issue        *Issue                 `json:"issue"`
-	Issues       *IssueConnection       `json:"issues"`
-	PullRequest  *PullRequest           `json:"pullRequest"`
-	PullRequests *PullRequestConnection `json:"pullRequests"`
+	ID         string               `json:"id"`
+	Owner      *User                `json:"owner"`
+	Name       string               `json:"name"`
+	Visibility RepositoryVisibility `json:"visibility"`
+	// ログインユーザーがこのリポジトリに持つ権限。読めるだけの公開リポジトリではREAD
+	ViewerPermission *RepositoryPermission `json:"viewerPermission,omitempty"`
+	// リポジトリに設定されている、Issueの作成などのやり取りの制限
+	InteractionAbility *RepositoryInteractionAbility `json:"interactionAbility"`
+	CreatedAt          time.Time                     `json:"createdAt"`
+	Issue              *Issue                        `json:"issue,omitempty"`
+	Issues             *IssueConnection              `json:"issues"`
+	PullRequest        *PullRequest                  `json:"pullRequest,omitempty"`
+	PullRequests       *PullRequestConnection        `json:"pullRequests"`
 }
 
 func (Repository) IsNode()            {}
 func (this Repository) GetID() string { return this.ID }
 
-type User struct {
+type RepositoryInteractionAbility struct {
+	Limit RepositoryInteractionLimit `json:"limit"`
+	// 制限が解除される日時。制限がない場合はnull
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// リポジトリへの招待。招待されたユーザーと、リポジトリのADMINだけが参照できる
+type RepositoryInvitation struct {
 	ID         string               `json:"id"`
-	Name       string               `json:"name"`
-	ProjectV2  *ProjectV2           `json:"projectV2"`
-	ProjectV2s *ProjectV2Connection `json:"projectV2s"`
+	Repository *Repository          `json:"repository"`
+	Invitee    *User                `json:"invitee"`
+	Inviter    *User                `json:"inviter"`
+	Permission RepositoryPermission `json:"permission"`
+	CreatedAt  time.Time            `json:"createdAt"`
+}
+
+func (RepositoryInvitation) IsNode()            {}
+func (this RepositoryInvitation) GetID() string { return this.ID }
+
+type RevokePersonalAccessTokenInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type RevokePersonalAccessTokenPayload struct {
+	ClientMutationID    *string              `json:"clientMutationId,omitempty"`
+	PersonalAccessToken *PersonalAccessToken `json:"personalAccessToken,omitempty"`
+	UserErrors          []*UserError         `json:"userErrors"`
+}
+
+type SetRepositoryInteractionLimitInput struct {
+	ClientMutationID *string                    `json:"clientMutationId,omitempty"`
+	RepositoryID     string                     `json:"repositoryId"`
+	Limit            RepositoryInteractionLimit `json:"limit"`
+	// 省略した場合はONE_DAY
+	Expiry *RepositoryInteractionLimitExpiry `json:"expiry,omitempty"`
+}
+
+type SetRepositoryInteractionLimitPayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	Repository       *Repository  `json:"repository,omitempty"`
+	UserErrors       []*UserError `json:"userErrors"`
+}
+
+type SignUpInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Name             string  `json:"name"`
+	// 8バイト以上72バイト以下
+	Password string `json:"password"`
+}
+
+// 登録に成功した場合は、そのままログインしてセッションのCookieを返す
+type SignUpPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	User             *User   `json:"user,omitempty"`
+	// Cookieで認証するPOSTのX-CSRF-Tokenヘッダに入れるトークン
+	CsrfToken  *string      `json:"csrfToken,omitempty"`
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type Subscription struct {
+}
+
+type UnarchiveProjectV2ItemInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ItemID           string  `json:"itemId"`
+	ProjectID        string  `json:"projectId"`
+}
+
+type UnarchiveProjectV2ItemPayload struct {
+	ClientMutationID *string        `json:"clientMutationId,omitempty"`
+	Item             *ProjectV2Item `json:"item,omitempty"`
+	UserErrors       []*UserError   `json:"userErrors"`
+}
+
+type UnblockUserInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	UserID           string  `json:"userId"`
+}
+
+type UnblockUserPayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	User             *User        `json:"user,omitempty"`
+	UserErrors       []*UserError `json:"userErrors"`
+}
+
+type UpdateIssueInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+	Title            *string `json:"title,omitempty"`
+	Closed           *bool   `json:"closed,omitempty"`
+}
+
+type UpdateIssuePayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	Issue            *Issue       `json:"issue,omitempty"`
+	UserErrors       []*UserError `json:"userErrors"`
+}
+
+type UpdatePullRequestInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	PullRequestID    string  `json:"pullRequestId"`
+	BaseRefName      *string `json:"baseRefName,omitempty"`
+	Closed           *bool   `json:"closed,omitempty"`
+}
+
+type UpdatePullRequestPayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	PullRequest      *PullRequest `json:"pullRequest,omitempty"`
+	UserErrors       []*UserError `json:"userErrors"`
+}
+
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// サイトの管理者か。管理者はX-Impersonate-Userヘッダで他のユーザーになりすませる
+	IsSiteAdmin bool `json:"isSiteAdmin"`
+	// ログインユーザーがこのユーザーをブロックしているか
+	IsBlockedByViewer bool                 `json:"isBlockedByViewer"`
+	ProjectV2         *ProjectV2           `json:"projectV2,omitempty"`
+	ProjectV2s        *ProjectV2Connection `json:"projectV2s"`
 }
 
 func (User) IsNode()            {}
 func (this User) GetID() string { return this.ID }
+
+type UserError struct {
+	Message string        `json:"message"`
+	Path    []string      `json:"path,omitempty"`
+	Code    UserErrorCode `json:"code"`
+}
+
+type AuditLogOutcome string
+
+const (
+	AuditLogOutcomeSuccess AuditLogOutcome = "SUCCESS"
+	// ペイロードのuserErrorsでエラーを返した
+	AuditLogOutcomeUserError AuditLogOutcome = "USER_ERROR"
+	// トップレベルのerrorsでエラーを返した
+	AuditLogOutcomeError AuditLogOutcome = "ERROR"
+)
+
+var AllAuditLogOutcome = []AuditLogOutcome{
+	AuditLogOutcomeSuccess,
+	AuditLogOutcomeUserError,
+	AuditLogOutcomeError,
+}
+
+func (e AuditLogOutcome) IsValid() bool {
+	switch e {
+	case AuditLogOutcomeSuccess, AuditLogOutcomeUserError, AuditLogOutcomeError:
+		return true
+	}
+	return false
+}
+
+func (e AuditLogOutcome) String() string {
+	return string(e)
+}
+
+func (e *AuditLogOutcome) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditLogOutcome(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditLogOutcome", str)
+	}
+	return nil
+}
+
+func (e AuditLogOutcome) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ChangeAction string
+
+const (
+	ChangeActionCreated ChangeAction = "CREATED"
+	ChangeActionUpdated ChangeAction = "UPDATED"
+)
+
+var AllChangeAction = []ChangeAction{
+	ChangeActionCreated,
+	ChangeActionUpdated,
+}
+
+func (e ChangeAction) IsValid() bool {
+	switch e {
+	case ChangeActionCreated, ChangeActionUpdated:
+		return true
+	}
+	return false
+}
+
+func (e ChangeAction) String() string {
+	return string(e)
+}
+
+func (e *ChangeAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeAction", str)
+	}
+	return nil
+}
+
+func (e ChangeAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RepositoryInteractionLimit string
+
+const (
+	// 以前にIssueを作成したことがあるユーザーとcollaboratorだけがやり取りできる
+	RepositoryInteractionLimitContributorsOnly RepositoryInteractionLimit = "CONTRIBUTORS_ONLY"
+	// collaboratorだけがやり取りできる
+	RepositoryInteractionLimitCollaboratorsOnly RepositoryInteractionLimit = "COLLABORATORS_ONLY"
+	RepositoryInteractionLimitNoLimit           RepositoryInteractionLimit = "NO_LIMIT"
+)
+
+var AllRepositoryInteractionLimit = []RepositoryInteractionLimit{
+	RepositoryInteractionLimitContributorsOnly,
+	RepositoryInteractionLimitCollaboratorsOnly,
+	RepositoryInteractionLimitNoLimit,
+}
+
+func (e RepositoryInteractionLimit) IsValid() bool {
+	switch e {
+	case RepositoryInteractionLimitContributorsOnly, RepositoryInteractionLimitCollaboratorsOnly, RepositoryInteractionLimitNoLimit:
+		return true
+	}
+	return false
+}
+
+func (e RepositoryInteractionLimit) String() string {
+	return string(e)
+}
+
+func (e *RepositoryInteractionLimit) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RepositoryInteractionLimit(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RepositoryInteractionLimit", str)
+	}
+	return nil
+}
+
+func (e RepositoryInteractionLimit) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RepositoryInteractionLimitExpiry string
+
+const (
+	RepositoryInteractionLimitExpiryOneDay    RepositoryInteractionLimitExpiry = "ONE_DAY"
+	RepositoryInteractionLimitExpiryThreeDays RepositoryInteractionLimitExpiry = "THREE_DAYS"
+	RepositoryInteractionLimitExpiryOneWeek   RepositoryInteractionLimitExpiry = "ONE_WEEK"
+	RepositoryInteractionLimitExpiryOneMonth  RepositoryInteractionLimitExpiry = "ONE_MONTH"
+	RepositoryInteractionLimitExpirySixMonths RepositoryInteractionLimitExpiry = "SIX_MONTHS"
+)
+
+var AllRepositoryInteractionLimitExpiry = []RepositoryInteractionLimitExpiry{
+	RepositoryInteractionLimitExpiryOneDay,
+	RepositoryInteractionLimitExpiryThreeDays,
+	RepositoryInteractionLimitExpiryOneWeek,
+	RepositoryInteractionLimitExpiryOneMonth,
+	RepositoryInteractionLimitExpirySixMonths,
+}
+
+func (e RepositoryInteractionLimitExpiry) IsValid() bool {
+	switch e {
+	case RepositoryInteractionLimitExpiryOneDay, RepositoryInteractionLimitExpiryThreeDays, RepositoryInteractionLimitExpiryOneWeek, RepositoryInteractionLimitExpiryOneMonth, RepositoryInteractionLimitExpirySixMonths:
+		return true
+	}
+	return false
+}
+
+func (e RepositoryInteractionLimitExpiry) String() string {
+	return string(e)
+}
+
+func (e *RepositoryInteractionLimitExpiry) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RepositoryInteractionLimitExpiry(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RepositoryInteractionLimitExpiry", str)
+	}
+	return nil
+}
+
+func (e RepositoryInteractionLimitExpiry) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// リポジトリに対する権限。後ろのものほど強く、前のものを全て含む
+type RepositoryPermission string
+
+const (
+	RepositoryPermissionRead   RepositoryPermission = "READ"
+	RepositoryPermissionTriage RepositoryPermission = "TRIAGE"
+	RepositoryPermissionWrite  RepositoryPermission = "WRITE"
+	RepositoryPermissionAdmin  RepositoryPermission = "ADMIN"
+)
+
+var AllRepositoryPermission = []RepositoryPermission{
+	RepositoryPermissionRead,
+	RepositoryPermissionTriage,
+	RepositoryPermissionWrite,
+	RepositoryPermissionAdmin,
+}
+
+func (e RepositoryPermission) IsValid() bool {
+	switch e {
+	case RepositoryPermissionRead, RepositoryPermissionTriage, RepositoryPermissionWrite, RepositoryPermissionAdmin:
+		return true
+	}
+	return false
+}
+
+func (e RepositoryPermission) String() string {
+	return string(e)
+}
+
+func (e *RepositoryPermission) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RepositoryPermission(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RepositoryPermission", str)
+	}
+	return nil
+}
+
+func (e RepositoryPermission) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RepositoryVisibility string
+
+const (
+	RepositoryVisibilityPublic  RepositoryVisibility = "PUBLIC"
+	RepositoryVisibilityPrivate RepositoryVisibility = "PRIVATE"
+)
+
+var AllRepositoryVisibility = []RepositoryVisibility{
+	RepositoryVisibilityPublic,
+	RepositoryVisibilityPrivate,
+}
+
+func (e RepositoryVisibility) IsValid() bool {
+	switch e {
+	case RepositoryVisibilityPublic, RepositoryVisibilityPrivate:
+		return true
+	}
+	return false
+}
+
+func (e RepositoryVisibility) String() string {
+	return string(e)
+}
+
+func (e *RepositoryVisibility) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RepositoryVisibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RepositoryVisibility", str)
+	}
+	return nil
+}
+
+func (e RepositoryVisibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UserErrorCode string
+
+const (
+	UserErrorCodeNotFound        UserErrorCode = "NOT_FOUND"
+	UserErrorCodeForbidden       UserErrorCode = "FORBIDDEN"
+	UserErrorCodeConflict        UserErrorCode = "CONFLICT"
+	UserErrorCodeValidation      UserErrorCode = "VALIDATION"
+	UserErrorCodeUnauthenticated UserErrorCode = "UNAUTHENTICATED"
+)
+
+var AllUserErrorCode = []UserErrorCode{
+	UserErrorCodeNotFound,
+	UserErrorCodeForbidden,
+	UserErrorCodeConflict,
+	UserErrorCodeValidation,
+	UserErrorCodeUnauthenticated,
+}
+
+func (e UserErrorCode) IsValid() bool {
+	switch e {
+	case UserErrorCodeNotFound, UserErrorCodeForbidden, UserErrorCodeConflict, UserErrorCodeValidation, UserErrorCodeUnauthenticated:
+		return true
+	}
+	return false
+}
+
+func (e UserErrorCode) String() string {
+	return string(e)
+}
+
+func (e *UserErrorCode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserErrorCode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserErrorCode", str)
+	}
+	return nil
+}
+
+func (e UserErrorCode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
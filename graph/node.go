@@ -0,0 +1,158 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+type nodeThunk func() (model.Node, error)
+
+// nodeFetcher は、ある型のノードをグローバルIDから取得する方法
+type nodeFetcher struct {
+	// 1件だけ取得する
+	get func(ctx context.Context, r *Resolver, id string) (model.Node, error)
+	// Loaderに登録して、後でまとめて取得する
+	load func(ctx context.Context, r *Resolver, id string) nodeThunk
+}
+
+// nodeRegistry は、Nodeを実装する全ての型について取得方法を登録したもの
+// Nodeを実装する型を増やした場合はここに追加する
+var nodeRegistry = map[globalid.Type]nodeFetcher{
+	globalid.User: {
+		get: func(ctx context.Context, r *Resolver, id string) (model.Node, error) {
+			return asNode(r.Srv.GetUserByID(ctx, id))
+		},
+		load: func(ctx context.Context, r *Resolver, id string) nodeThunk {
+			return toNodeThunk(r.loaders(ctx).UserLoader.Load(ctx, id))
+		},
+	},
+	globalid.Repository: {
+		get: func(ctx context.Context, r *Resolver, id string) (model.Node, error) {
+			return asNode(r.Srv.GetRepoByID(ctx, id))
+		},
+		load: func(ctx context.Context, r *Resolver, id string) nodeThunk {
+			return toNodeThunk(r.loaders(ctx).RepoLoader.Load(ctx, id))
+		},
+	},
+	globalid.Issue: {
+		get: func(ctx context.Context, r *Resolver, id string) (model.Node, error) {
+			return asNode(r.Srv.GetIssueByID(ctx, id))
+		},
+		load: func(ctx context.Context, r *Resolver, id string) nodeThunk {
+			return toNodeThunk(r.loaders(ctx).IssueLoader.Load(ctx, id))
+		},
+	},
+	globalid.PullRequest: {
+		get: func(ctx context.Context, r *Resolver, id string) (model.Node, error) {
+			return asNode(r.Srv.GetPullRequestByID(ctx, id))
+		},
+		load: func(ctx context.Context, r *Resolver, id string) nodeThunk {
+			return toNodeThunk(r.loaders(ctx).PullRequestLoader.Load(ctx, id))
+		},
+	},
+	globalid.ProjectV2: {
+		get: func(ctx context.Context, r *Resolver, id string) (model.Node, error) {
+			return asNode(r.Srv.GetProjectByID(ctx, id))
+		},
+		load: func(ctx context.Context, r *Resolver, id string) nodeThunk {
+			return toNodeThunk(r.loaders(ctx).ProjectLoader.Load(ctx, id))
+		},
+	},
+	globalid.ProjectV2Item: {
+		get: func(ctx context.Context, r *Resolver, id string) (model.Node, error) {
+			return asNode(r.Srv.GetProjectItemByID(ctx, id))
+		},
+		load: func(ctx context.Context, r *Resolver, id string) nodeThunk {
+			return toNodeThunk(r.loaders(ctx).ProjectItemLoader.Load(ctx, id))
+		},
+	},
+	globalid.RepositoryInvitation: {
+		get: func(ctx context.Context, r *Resolver, id string) (model.Node, error) {
+			return asNode(r.Srv.GetRepositoryInvitation(ctx, id))
+		},
+		// 招待された人とリポジトリのADMINしか引けないので、まとめて取得することはない
+		load: func(ctx context.Context, r *Resolver, id string) nodeThunk {
+			return func() (model.Node, error) {
+				return asNode(r.Srv.GetRepositoryInvitation(ctx, id))
+			}
+		},
+	},
+	globalid.PersonalAccessToken: {
+		get: getPersonalAccessTokenNode,
+		// 本人のトークンしか引けず、まとめて取得することもないのでLoaderは使わない
+		load: func(ctx context.Context, r *Resolver, id string) nodeThunk {
+			return func() (model.Node, error) {
+				return getPersonalAccessTokenNode(ctx, r, id)
+			}
+		},
+	},
+	globalid.AuditLogEntry: {
+		get: func(ctx context.Context, r *Resolver, id string) (model.Node, error) {
+			return asNode(r.Srv.GetAuditLogEntry(ctx, id))
+		},
+		// サイトの管理者しか引けないので、まとめて取得することはない
+		load: func(ctx context.Context, r *Resolver, id string) nodeThunk {
+			return func() (model.Node, error) {
+				return asNode(r.Srv.GetAuditLogEntry(ctx, id))
+			}
+		},
+	},
+}
+
+// getPersonalAccessTokenNode は、ログインユーザー本人のトークンだけを返す
+// 他人のトークンや未認証の場合は、存在しないものとして扱う
+func getPersonalAccessTokenNode(ctx context.Context, r *Resolver, id string) (model.Node, error) {
+	viewer, ok := auth.GetUser(ctx)
+	if !ok {
+		return nil, apperrors.New(apperrors.NotFound, "not found")
+	}
+	return asNode(r.Srv.GetPersonalAccessToken(ctx, viewer.ID, id))
+}
+
+// asNode は、型付きのnilをmodel.Nodeに入れないようにしつつ変換する
+func asNode[T model.Node](node T, err error) (model.Node, error) {
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func toNodeThunk[T model.Node](thunk dataloader.Thunk[T]) nodeThunk {
+	return func() (model.Node, error) {
+		return asNode(thunk())
+	}
+}
+
+// getNode は、グローバルIDの型に応じてノードを1件取得する
+func (r *Resolver) getNode(ctx context.Context, id string) (model.Node, error) {
+	gid, err := globalid.Decode(id)
+	if err != nil {
+		return nil, err
+	}
+	fetcher, ok := nodeRegistry[gid.Type]
+	if !ok {
+		return nil, fmt.Errorf("node type %q is not registered", gid.Type)
+	}
+	return fetcher.get(ctx, r, id)
+}
+
+// loadNode は、グローバルIDの型に対応するLoaderに登録する
+// 不正なIDの場合はnilを返す
+func (r *Resolver) loadNode(ctx context.Context, id string) nodeThunk {
+	gid, err := globalid.Decode(id)
+	if err != nil {
+		return nil
+	}
+	fetcher, ok := nodeRegistry[gid.Type]
+	if !ok {
+		return nil
+	}
+	return fetcher.load(ctx, r, id)
+}
@@ -0,0 +1,25 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+)
+
+// grantableScopes は、新しく発行するトークンのスコープを検証する
+// 今のトークンが持っていないスコープを与えて権限を広げることはできない
+func grantableScopes(ctx context.Context, requested []string) (scope.Set, error) {
+	scopes, err := scope.Parse(requested)
+	if err != nil {
+		return nil, err
+	}
+	granted, _ := auth.GetScopes(ctx)
+	for _, s := range scopes {
+		if !granted.Has(s) {
+			return nil, apperrors.New(apperrors.Forbidden, "cannot grant the %q scope, which the current token does not have", s)
+		}
+	}
+	return scopes, nil
+}
@@ -0,0 +1,185 @@
+// Package policy は、誰がどの操作をしてよいかのルールを型と操作ごとに1か所で宣言する
+// ルールは@authディレクティブとservicesの両方から同じ名前で参照する
+package policy
+
+import (
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+)
+
+// Action は、"型.操作"の形をしたルールの名前
+type Action string
+
+const (
+	IssueCreate                 Action = "Issue.create"
+	IssueUpdate                 Action = "Issue.update"
+	PullRequestUpdate           Action = "PullRequest.update"
+	RepositoryInvite            Action = "Repository.invite"
+	RepositoryInvitationView    Action = "RepositoryInvitation.view"
+	RepositoryInvitationRespond Action = "RepositoryInvitation.respond"
+	ProjectV2Write              Action = "ProjectV2.write"
+	PersonalAccessTokenManage   Action = "PersonalAccessToken.manage"
+	UserSignUp                  Action = "User.signUp"
+	SessionCreate               Action = "Session.create"
+	SessionDelete               Action = "Session.delete"
+	UserImpersonate             Action = "User.impersonate"
+	AuditLogRead                Action = "AuditLog.read"
+	UserBlock                   Action = "User.block"
+	RepositoryLimitInteractions Action = "Repository.limitInteractions"
+	PersistedQueryRead          Action = "PersistedQuery.read"
+)
+
+// Facts は、ルールの判定に使う閲覧者と対象についての情報
+type Facts struct {
+	// Viewer は、ログインしていない場合はnil
+	Viewer *model.User
+	// Permission は、対象が属するリポジトリに閲覧者が持つ権限
+	// リポジトリに属さない対象や、読めないリポジトリの場合は空文字
+	Permission model.RepositoryPermission
+	// OwnerID は、対象の持ち主(Issueの作成者、プロジェクトやトークンの持ち主、招待された人)のID
+	OwnerID string
+}
+
+// Rule は、Factsから操作を許可するかを決める
+type Rule func(f Facts) bool
+
+// rules は、全ての操作のルール
+// 操作を増やした場合はここに追加し、policy_test.goの権限表にも追加する
+var rules = map[Action]Rule{
+	IssueCreate: RepoPermission(model.RepositoryPermissionRead),
+	// Issueの作成者は、リポジトリを読める限り自分のIssueを更新できる
+	IssueUpdate:                 Any(All(IsOwner, RepoPermission(model.RepositoryPermissionRead)), RepoPermission(model.RepositoryPermissionTriage)),
+	PullRequestUpdate:           RepoPermission(model.RepositoryPermissionWrite),
+	RepositoryInvite:            RepoPermission(model.RepositoryPermissionAdmin),
+	RepositoryInvitationView:    Any(IsOwner, RepoPermission(model.RepositoryPermissionAdmin)),
+	RepositoryInvitationRespond: IsOwner,
+	ProjectV2Write:              IsOwner,
+	PersonalAccessTokenManage:   IsOwner,
+	UserSignUp:                  Everyone,
+	SessionCreate:               Everyone,
+	SessionDelete:               IsOwner,
+	UserImpersonate:             IsSiteAdmin,
+	AuditLogRead:                IsSiteAdmin,
+	// ブロックするのは閲覧者自身の設定なので、ログインしていれば誰でもできる
+	UserBlock:                   Everyone,
+	RepositoryLimitInteractions: RepoPermission(model.RepositoryPermissionAdmin),
+	PersistedQueryRead:          IsSiteAdmin,
+}
+
+// anonymous は、ログインしていなくても行える操作
+var anonymous = map[Action]bool{
+	UserSignUp:    true,
+	SessionCreate: true,
+}
+
+// viewerOnly は、対象のリソースに関係なく閲覧者だけで判定できる操作
+// @authディレクティブで判定を終えられるので、servicesでの判定し忘れに頼らない
+var viewerOnly = map[Action]bool{
+	UserSignUp:      true,
+	SessionCreate:   true,
+	UserImpersonate: true,
+	AuditLogRead:    true,
+	UserBlock:       true,
+	// 管理用のエンドポイントは@authを通らないので、apqで同じルールを使って判定する
+	PersistedQueryRead: true,
+}
+
+// Lookup は、actionのルールが登録されているかを返す
+func Lookup(action Action) (Rule, bool) {
+	rule, ok := rules[action]
+	return rule, ok
+}
+
+// Actions は、登録されている全ての操作を返す
+func Actions() []Action {
+	result := make([]Action, 0, len(rules))
+	for action := range rules {
+		result = append(result, action)
+	}
+	return result
+}
+
+// AllowsAnonymous は、actionがログインしていなくても行える操作かを返す
+func AllowsAnonymous(action Action) bool {
+	return anonymous[action]
+}
+
+// IsViewerOnly は、actionのルールを閲覧者だけで判定できるかを返す
+func IsViewerOnly(action Action) bool {
+	return viewerOnly[action]
+}
+
+// Authorize は、fがactionのルールを満たすことを確かめる
+// 未ログインの閲覧者には、anonymousに無い操作を許可しない
+func Authorize(action Action, f Facts) error {
+	rule, ok := rules[action]
+	if !ok {
+		return apperrors.New(apperrors.Internal, "unknown policy %q", action)
+	}
+	if f.Viewer == nil && !anonymous[action] {
+		return apperrors.New(apperrors.Forbidden, "not authenticated")
+	}
+	if !rule(f) {
+		return apperrors.New(apperrors.Forbidden, "viewer is not allowed to %s", action)
+	}
+	return nil
+}
+
+// permissionRank は、権限の強さ。強い権限は弱い権限を全て含む
+var permissionRank = map[model.RepositoryPermission]int{
+	model.RepositoryPermissionRead:   1,
+	model.RepositoryPermissionTriage: 2,
+	model.RepositoryPermissionWrite:  3,
+	model.RepositoryPermissionAdmin:  4,
+}
+
+// Includes は、権限haveが権限wantを含むかを返す
+func Includes(have, want model.RepositoryPermission) bool {
+	return have != "" && permissionRank[have] >= permissionRank[want]
+}
+
+// RepoPermission は、リポジトリにwant以上の権限を持つことを求める
+func RepoPermission(want model.RepositoryPermission) Rule {
+	return func(f Facts) bool {
+		return Includes(f.Permission, want)
+	}
+}
+
+// Everyone は、誰にでも許可する
+func Everyone(f Facts) bool {
+	return true
+}
+
+// IsOwner は、閲覧者が対象の持ち主であることを求める
+func IsOwner(f Facts) bool {
+	return f.Viewer != nil && f.OwnerID != "" && f.Viewer.ID == f.OwnerID
+}
+
+// IsSiteAdmin は、閲覧者がサイトの管理者であることを求める
+func IsSiteAdmin(f Facts) bool {
+	return f.Viewer != nil && f.Viewer.IsSiteAdmin
+}
+
+// Any は、いずれかのルールを満たすことを求める
+func Any(rules ...Rule) Rule {
+	return func(f Facts) bool {
+		for _, rule := range rules {
+			if rule(f) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// All は、全てのルールを満たすことを求める
+func All(rules ...Rule) Rule {
+	return func(f Facts) bool {
+		for _, rule := range rules {
+			if !rule(f) {
+				return false
+			}
+		}
+		return true
+	}
+}
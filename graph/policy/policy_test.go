@@ -0,0 +1,145 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/internal"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// roles は、権限表の列になる閲覧者と対象の関係
+// 対象の持ち主はU_1で、リポジトリの権限を持つのはU_2
+var roles = map[string]policy.Facts{
+	"anonymous": {OwnerID: "U_1", Permission: model.RepositoryPermissionRead},
+	"stranger":  {Viewer: &model.User{ID: "U_9"}, OwnerID: "U_1"},
+	"reader":    {Viewer: &model.User{ID: "U_2"}, OwnerID: "U_1", Permission: model.RepositoryPermissionRead},
+	"triager":   {Viewer: &model.User{ID: "U_2"}, OwnerID: "U_1", Permission: model.RepositoryPermissionTriage},
+	"writer":    {Viewer: &model.User{ID: "U_2"}, OwnerID: "U_1", Permission: model.RepositoryPermissionWrite},
+	"admin":     {Viewer: &model.User{ID: "U_2"}, OwnerID: "U_1", Permission: model.RepositoryPermissionAdmin},
+	// ownerはリポジトリを読めない持ち主(招待された人など)、authorは読める持ち主
+	"owner":  {Viewer: &model.User{ID: "U_1"}, OwnerID: "U_1"},
+	"author": {Viewer: &model.User{ID: "U_1"}, OwnerID: "U_1", Permission: model.RepositoryPermissionRead},
+	// サイトの管理者でも、リポジトリの権限や持ち主であることの代わりにはならない
+	"site admin": {Viewer: &model.User{ID: "U_3", IsSiteAdmin: true}, OwnerID: "U_1"},
+}
+
+// permissionMatrix は、操作ごとに許可される役割
+// ここに無い役割は全て拒否される
+var permissionMatrix = map[policy.Action][]string{
+	policy.IssueCreate:                 {"reader", "triager", "writer", "admin", "author"},
+	policy.IssueUpdate:                 {"triager", "writer", "admin", "author"},
+	policy.PullRequestUpdate:           {"writer", "admin"},
+	policy.RepositoryInvite:            {"admin"},
+	policy.RepositoryInvitationView:    {"admin", "owner", "author"},
+	policy.RepositoryInvitationRespond: {"owner", "author"},
+	policy.ProjectV2Write:              {"owner", "author"},
+	policy.PersonalAccessTokenManage:   {"owner", "author"},
+	policy.UserSignUp:                  {"anonymous", "stranger", "reader", "triager", "writer", "admin", "owner", "author", "site admin"},
+	policy.SessionCreate:               {"anonymous", "stranger", "reader", "triager", "writer", "admin", "owner", "author", "site admin"},
+	policy.SessionDelete:               {"owner", "author"},
+	policy.UserImpersonate:             {"site admin"},
+	policy.AuditLogRead:                {"site admin"},
+	policy.UserBlock:                   {"stranger", "reader", "triager", "writer", "admin", "owner", "author", "site admin"},
+	policy.RepositoryLimitInteractions: {"admin"},
+	policy.PersistedQueryRead:          {"site admin"},
+}
+
+// mutationPolicies は、mutationごとに@authで指定するべきルール
+var mutationPolicies = map[string]policy.Action{
+	"addProjectV2ItemById":          policy.ProjectV2Write,
+	"deleteProjectV2Item":           policy.ProjectV2Write,
+	"archiveProjectV2Item":          policy.ProjectV2Write,
+	"unarchiveProjectV2Item":        policy.ProjectV2Write,
+	"createIssue":                   policy.IssueCreate,
+	"updateIssue":                   policy.IssueUpdate,
+	"updatePullRequest":             policy.PullRequestUpdate,
+	"createPersonalAccessToken":     policy.PersonalAccessTokenManage,
+	"revokePersonalAccessToken":     policy.PersonalAccessTokenManage,
+	"inviteRepositoryCollaborator":  policy.RepositoryInvite,
+	"acceptRepositoryInvitation":    policy.RepositoryInvitationRespond,
+	"declineRepositoryInvitation":   policy.RepositoryInvitationRespond,
+	"signUp":                        policy.UserSignUp,
+	"login":                         policy.SessionCreate,
+	"logout":                        policy.SessionDelete,
+	"blockUser":                     policy.UserBlock,
+	"unblockUser":                   policy.UserBlock,
+	"setRepositoryInteractionLimit": policy.RepositoryLimitInteractions,
+}
+
+func TestPermissionMatrix(t *testing.T) {
+	for action := range permissionMatrix {
+		if _, ok := policy.Lookup(action); !ok {
+			t.Errorf("%s is in the permission matrix, but not registered", action)
+		}
+	}
+	for _, action := range policy.Actions() {
+		allowed, ok := permissionMatrix[action]
+		if !ok {
+			t.Errorf("%s is missing from the permission matrix", action)
+			continue
+		}
+		allow := make(map[string]bool, len(allowed))
+		for _, role := range allowed {
+			allow[role] = true
+		}
+		for role, facts := range roles {
+			err := policy.Authorize(action, facts)
+			if allow[role] && err != nil {
+				t.Errorf("%s should be allowed for %s, but got %v", action, role, err)
+			}
+			if !allow[role] && apperrors.From(err).Code != apperrors.Forbidden {
+				t.Errorf("%s should be forbidden for %s, but got %v", action, role, err)
+			}
+		}
+	}
+}
+
+// TestEveryMutationHasPolicy は、全てのmutationが想定どおりのルールで守られていることを確かめる
+// mutationを追加した場合は、mutationPoliciesにも追加する
+func TestEveryMutationHasPolicy(t *testing.T) {
+	schema := internal.NewExecutableSchema(internal.Config{}).Schema()
+
+	got := map[string]policy.Action{}
+	for _, field := range schema.Mutation.Fields {
+		directive := field.Directives.ForName("auth")
+		if directive == nil {
+			t.Errorf("mutation %s has no @auth directive", field.Name)
+			continue
+		}
+		action := policy.Action(directive.Arguments.ForName("policy").Value.Raw)
+		if _, ok := policy.Lookup(action); !ok {
+			t.Errorf("mutation %s uses unknown policy %q", field.Name, action)
+		}
+		got[field.Name] = action
+	}
+	if diff := cmp.Diff(mutationPolicies, got); diff != "" {
+		t.Errorf("mutation policies mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestViewerOnlyRulesIgnoreResource(t *testing.T) {
+	// 閲覧者だけで判定できるルールは、対象の持ち主や権限が無くても同じ結果になる
+	for _, action := range policy.Actions() {
+		if !policy.IsViewerOnly(action) {
+			continue
+		}
+		for role, facts := range roles {
+			withResource := policy.Authorize(action, facts)
+			viewerOnly := policy.Authorize(action, policy.Facts{Viewer: facts.Viewer})
+			if (withResource == nil) != (viewerOnly == nil) {
+				t.Errorf("%s by %s depends on the resource: %v, %v", action, role, withResource, viewerOnly)
+			}
+		}
+	}
+}
+
+func TestAuthorizeUnknownPolicy(t *testing.T) {
+	err := policy.Authorize("Issue.delete", roles["admin"])
+	if apperrors.From(err).Code != apperrors.Internal {
+		t.Errorf("want internal error, but got %v", err)
+	}
+}
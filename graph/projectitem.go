@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+)
+
+// asContent は、nilのIssue・PullRequestを型付きのnilとしてではなくnullとして返す
+func asContent[T interface {
+	model.ProjectV2ItemContent
+	comparable
+}](content T, err error) (model.ProjectV2ItemContent, error) {
+	var zero T
+	if err != nil || content == zero {
+		return nil, err
+	}
+	return content, nil
+}
+
+// validateProjectItemInput は、projectId/itemIdの入力を検証する
+// 問題がある場合はuserErrorsに変換して返す
+func validateProjectItemInput(projectID, itemID string) ([]*model.UserError, error) {
+	if _, err := globalid.DecodeAs(projectID, globalid.ProjectV2); err != nil {
+		return userErrors(err, "input", "projectId")
+	}
+	if _, err := globalid.DecodeAs(itemID, globalid.ProjectV2Item); err != nil {
+		return userErrors(err, "input", "itemId")
+	}
+	return nil, nil
+}
+
+// setProjectV2ItemArchived は、archive/unarchiveProjectV2Itemの共通処理
+func (r *mutationResolver) setProjectV2ItemArchived(ctx context.Context, projectID, itemID string, archived bool) (*model.ProjectV2Item, []*model.UserError, error) {
+	if userErrs, err := validateProjectItemInput(projectID, itemID); userErrs != nil || err != nil {
+		return nil, userErrs, err
+	}
+
+	item, err := r.Srv.SetProjectV2ItemArchived(ctx, projectID, itemID, archived)
+	if err != nil {
+		userErrs, err := userErrors(err, "input", "itemId")
+		return nil, userErrs, err
+	}
+	return item, []*model.UserError{}, nil
+}
+
+// subscribeProjectItems は、指定したプロジェクトのアイテムの変更のうち、typに一致するものだけを流す
+// 変換後の値はconvertで作る
+func subscribeProjectItems[T any](ctx context.Context, r *Resolver, projectID string, typ services.ProjectItemEventType, convert func(*model.ProjectV2Item) T) (<-chan T, error) {
+	if _, err := globalid.DecodeAs(projectID, globalid.ProjectV2); err != nil {
+		return nil, err
+	}
+	if _, err := r.getNode(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	events := r.Srv.SubscribeProjectItemEvents(ctx, projectID)
+	return forwardEvents(ctx, events, func(event *services.ProjectItemEvent) (T, bool) {
+		if event.Type != typ {
+			var zero T
+			return zero, false
+		}
+		return convert(event.Item), true
+	}), nil
+}
@@ -0,0 +1,63 @@
+// Package pubsub は、プロセス内で完結するトピック単位のpub/subを提供する
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriberBuffer は、購読者ごとのチャネルのバッファサイズ
+// 受信が追いつかずバッファが埋まった場合、そのイベントは捨てられる
+const subscriberBuffer = 16
+
+type Broker[T any] struct {
+	mu   sync.Mutex
+	subs map[string]map[chan T]struct{}
+}
+
+func New[T any]() *Broker[T] {
+	return &Broker[T]{
+		subs: make(map[string]map[chan T]struct{}),
+	}
+}
+
+// Subscribe は、topicに発行されたイベントを受け取るチャネルを返す
+// ctxが終了すると購読を解除してチャネルを閉じる
+func (b *Broker[T]) Subscribe(ctx context.Context, topic string) <-chan T {
+	ch := make(chan T, subscriberBuffer)
+
+	b.mu.Lock()
+	if b.subs[topic] == nil {
+		b.subs[topic] = make(map[chan T]struct{})
+	}
+	b.subs[topic][ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs[topic], ch)
+		if len(b.subs[topic]) == 0 {
+			delete(b.subs, topic)
+		}
+		close(ch)
+	}()
+
+	return ch
+}
+
+// Publish は、topicを購読している全員にイベントを送る
+// 遅い購読者のせいで発行側が止まらないよう、送れなかった分は捨てる
+func (b *Broker[T]) Publish(topic string, event T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs[topic] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/pubsub"
+)
+
+func receive(t *testing.T, ch <-chan string) (string, bool) {
+	t.Helper()
+
+	select {
+	case v, ok := <-ch:
+		return v, ok
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+		return "", false
+	}
+}
+
+func TestBroker(t *testing.T) {
+	b := pubsub.New[string]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch1 := b.Subscribe(ctx, "PJ_1")
+	ch2 := b.Subscribe(ctx, "PJ_1")
+	other := b.Subscribe(ctx, "PJ_2")
+
+	b.Publish("PJ_1", "added")
+
+	for _, ch := range []<-chan string{ch1, ch2} {
+		if v, _ := receive(t, ch); v != "added" {
+			t.Errorf("want added, but got %s", v)
+		}
+	}
+	select {
+	case v := <-other:
+		t.Errorf("PJ_2 subscriber received %s", v)
+	default:
+	}
+}
+
+func TestBrokerUnsubscribe(t *testing.T) {
+	b := pubsub.New[string]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := b.Subscribe(ctx, "PJ_1")
+	cancel()
+
+	// 購読を解除するとチャネルが閉じられる
+	if _, ok := receive(t, ch); ok {
+		t.Error("channel should be closed")
+	}
+	// 解除後に発行してもpanicしない
+	b.Publish("PJ_1", "added")
+}
+
+func TestBrokerSlowSubscriber(t *testing.T) {
+	b := pubsub.New[int]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_ = b.Subscribe(ctx, "PJ_1")
+
+	// 誰も受信しなくても発行側はブロックしない
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 1000; i++ {
+			b.Publish("PJ_1", i)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Publish blocked")
+	}
+}
@@ -8,5 +8,14 @@ import "github.com/saki-engineering/graphql-sample/graph/services"
 
 type Resolver struct {
 	Srv services.Services
+	// MaxPageSize は、nodesに1度に渡せるIDの数の上限。0の場合はservices.DefaultMaxPageSize
+	MaxPageSize int
 	*Loaders
 }
+
+func (r *Resolver) maxPageSize() int {
+	if r.MaxPageSize <= 0 {
+		return services.DefaultMaxPageSize
+	}
+	return r.MaxPageSize
+}
@@ -2,21 +2,42 @@ package graph
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.22
+// Code generated by github.com/99designs/gqlgen version v0.17.61
 
 import (
 	"context"
 	"errors"
-	"strings"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
 	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/services"
 	"github.com/saki-engineering/graphql-sample/internal"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+	"github.com/saki-engineering/graphql-sample/middlewares/ratelimit"
 )
 
+// Actor is the resolver for the actor field.
+func (r *auditLogEntryResolver) Actor(ctx context.Context, obj *model.AuditLogEntry) (*model.User, error) {
+	if obj.Actor == nil {
+		return nil, nil
+	}
+	return r.loaders(ctx).UserLoader.Load(ctx, obj.Actor.ID)()
+}
+
+// Impersonator is the resolver for the impersonator field.
+func (r *auditLogEntryResolver) Impersonator(ctx context.Context, obj *model.AuditLogEntry) (*model.User, error) {
+	if obj.Impersonator == nil {
+		return nil, nil
+	}
+	return r.loaders(ctx).UserLoader.Load(ctx, obj.Impersonator.ID)()
+}
+
 // Author is the resolver for the author field.
 func (r *issueResolver) Author(ctx context.Context, obj *model.Issue) (*model.User, error) {
 	// 1. Loaderに登録(この時点では即時実行されない)
-	thunk := r.Loaders.UserLoader.Load(ctx, obj.Author.ID)
+	thunk := r.loaders(ctx).UserLoader.Load(ctx, obj.Author.ID)
 	// 2. Loaderが実行するまで待って、結果を受け取る
 	user, err := thunk()
 	if err != nil {
@@ -40,29 +61,512 @@ func (r *issueResolver) ProjectItems(ctx context.Context, obj *model.Issue, afte
 
 // AddProjectV2ItemByID is the resolver for the addProjectV2ItemById field.
 func (r *mutationResolver) AddProjectV2ItemByID(ctx context.Context, input model.AddProjectV2ItemByIDInput) (*model.AddProjectV2ItemByIDPayload, error) {
-	nElems := strings.SplitN(input.ContentID, "_", 2)
-	nType, _ := nElems[0], nElems[1]
+	payload := &model.AddProjectV2ItemByIDPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
 
-	switch nType {
-	case "ISSUE":
-		item, err := r.Srv.AddIssueInProjectV2(ctx, input.ProjectID, input.ContentID)
-		if err != nil {
+	if _, err := globalid.DecodeAs(input.ProjectID, globalid.ProjectV2); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "projectId"); err != nil {
 			return nil, err
 		}
-		return &model.AddProjectV2ItemByIDPayload{
-			Item: item,
-		}, nil
-	case "PR":
-		item, err := r.Srv.AddPullRequestInProjectV2(ctx, input.ProjectID, input.ContentID)
-		if err != nil {
+		return payload, nil
+	}
+	content, err := globalid.DecodeAs(input.ContentID, globalid.Issue, globalid.PullRequest)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "contentId"); err != nil {
 			return nil, err
 		}
-		return &model.AddProjectV2ItemByIDPayload{
-			Item: item,
-		}, nil
-	default:
-		return nil, errors.New("invalid content id")
+		return payload, nil
+	}
+
+	// 存在しないIDが指定された場合に、どちらの入力が原因なのかを返せるよう先に確かめておく
+	if _, err := r.getNode(ctx, input.ProjectID); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "projectId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	if _, err := r.getNode(ctx, input.ContentID); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "contentId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	var item *model.ProjectV2Item
+	switch content.Type {
+	case globalid.Issue:
+		item, err = r.Srv.AddIssueInProjectV2(ctx, input.ProjectID, input.ContentID)
+	case globalid.PullRequest:
+		item, err = r.Srv.AddPullRequestInProjectV2(ctx, input.ProjectID, input.ContentID)
+	}
+	if err != nil {
+		// プロジェクトに書き込めない場合はprojectId、内容が読めない場合はcontentIdが原因
+		field := "contentId"
+		if apperrors.From(err).Code == apperrors.Forbidden {
+			field = "projectId"
+		}
+		if payload.UserErrors, err = userErrors(err, "input", field); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	payload.Item = item
+	return payload, nil
+}
+
+// DeleteProjectV2Item is the resolver for the deleteProjectV2Item field.
+func (r *mutationResolver) DeleteProjectV2Item(ctx context.Context, input model.DeleteProjectV2ItemInput) (*model.DeleteProjectV2ItemPayload, error) {
+	payload := &model.DeleteProjectV2ItemPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	userErrs, err := validateProjectItemInput(input.ProjectID, input.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	if userErrs != nil {
+		payload.UserErrors = userErrs
+		return payload, nil
+	}
+
+	if err := r.Srv.DeleteProjectV2Item(ctx, input.ProjectID, input.ItemID); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "itemId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	payload.DeletedItemID = &input.ItemID
+	return payload, nil
+}
+
+// ArchiveProjectV2Item is the resolver for the archiveProjectV2Item field.
+func (r *mutationResolver) ArchiveProjectV2Item(ctx context.Context, input model.ArchiveProjectV2ItemInput) (*model.ArchiveProjectV2ItemPayload, error) {
+	item, userErrs, err := r.setProjectV2ItemArchived(ctx, input.ProjectID, input.ItemID, true)
+	if err != nil {
+		return nil, err
+	}
+	return &model.ArchiveProjectV2ItemPayload{
+		ClientMutationID: input.ClientMutationID,
+		Item:             item,
+		UserErrors:       userErrs,
+	}, nil
+}
+
+// UnarchiveProjectV2Item is the resolver for the unarchiveProjectV2Item field.
+func (r *mutationResolver) UnarchiveProjectV2Item(ctx context.Context, input model.UnarchiveProjectV2ItemInput) (*model.UnarchiveProjectV2ItemPayload, error) {
+	item, userErrs, err := r.setProjectV2ItemArchived(ctx, input.ProjectID, input.ItemID, false)
+	if err != nil {
+		return nil, err
+	}
+	return &model.UnarchiveProjectV2ItemPayload{
+		ClientMutationID: input.ClientMutationID,
+		Item:             item,
+		UserErrors:       userErrs,
+	}, nil
+}
+
+// CreateIssue is the resolver for the createIssue field.
+func (r *mutationResolver) CreateIssue(ctx context.Context, input model.CreateIssueInput) (*model.CreateIssuePayload, error) {
+	payload := &model.CreateIssuePayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if _, err := globalid.DecodeAs(input.RepositoryID, globalid.Repository); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "repositoryId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	if err := validateTitle(input.Title); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "title"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	// @requiresScopeを通っているので、ログインユーザーは必ずいる
+	author, _ := auth.GetUser(ctx)
+	issue, err := r.Srv.CreateIssue(ctx, input.RepositoryID, author.ID, input.Title)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "repositoryId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	payload.Issue = issue
+	return payload, nil
+}
+
+// UpdateIssue is the resolver for the updateIssue field.
+func (r *mutationResolver) UpdateIssue(ctx context.Context, input model.UpdateIssueInput) (*model.UpdateIssuePayload, error) {
+	payload := &model.UpdateIssuePayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if _, err := globalid.DecodeAs(input.ID, globalid.Issue); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "id"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	if input.Title != nil {
+		if err := validateTitle(*input.Title); err != nil {
+			if payload.UserErrors, err = userErrors(err, "input", "title"); err != nil {
+				return nil, err
+			}
+			return payload, nil
+		}
+	}
+
+	issue, err := r.Srv.UpdateIssue(ctx, input.ID, input.Title, input.Closed)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "id"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	payload.Issue = issue
+	return payload, nil
+}
+
+// UpdatePullRequest is the resolver for the updatePullRequest field.
+func (r *mutationResolver) UpdatePullRequest(ctx context.Context, input model.UpdatePullRequestInput) (*model.UpdatePullRequestPayload, error) {
+	payload := &model.UpdatePullRequestPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if _, err := globalid.DecodeAs(input.PullRequestID, globalid.PullRequest); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "pullRequestId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	pr, err := r.Srv.UpdatePullRequest(ctx, input.PullRequestID, input.BaseRefName, input.Closed)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "pullRequestId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	payload.PullRequest = pr
+	return payload, nil
+}
+
+// CreatePersonalAccessToken is the resolver for the createPersonalAccessToken field.
+func (r *mutationResolver) CreatePersonalAccessToken(ctx context.Context, input model.CreatePersonalAccessTokenInput) (*model.CreatePersonalAccessTokenPayload, error) {
+	payload := &model.CreatePersonalAccessTokenPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if err := validateName(input.Name); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "name"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	scopes, err := grantableScopes(ctx, input.Scopes)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "scopes"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	// @requiresScopeを通っているので、ログインユーザーは必ずいる
+	viewer, _ := auth.GetUser(ctx)
+	token, secret, err := r.Srv.CreatePersonalAccessToken(ctx, viewer.ID, input.Name, scopes, input.ExpiresAt)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "expiresAt"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	payload.PersonalAccessToken = token
+	payload.Token = &secret
+	return payload, nil
+}
+
+// RevokePersonalAccessToken is the resolver for the revokePersonalAccessToken field.
+func (r *mutationResolver) RevokePersonalAccessToken(ctx context.Context, input model.RevokePersonalAccessTokenInput) (*model.RevokePersonalAccessTokenPayload, error) {
+	payload := &model.RevokePersonalAccessTokenPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if _, err := globalid.DecodeAs(input.ID, globalid.PersonalAccessToken); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "id"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	// 他人のトークンはNOT_FOUNDになる
+	viewer, _ := auth.GetUser(ctx)
+	token, err := r.Srv.RevokePersonalAccessToken(ctx, viewer.ID, input.ID)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "id"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	payload.PersonalAccessToken = token
+	return payload, nil
+}
+
+// InviteRepositoryCollaborator is the resolver for the inviteRepositoryCollaborator field.
+func (r *mutationResolver) InviteRepositoryCollaborator(ctx context.Context, input model.InviteRepositoryCollaboratorInput) (*model.InviteRepositoryCollaboratorPayload, error) {
+	payload := &model.InviteRepositoryCollaboratorPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if _, err := globalid.DecodeAs(input.RepositoryID, globalid.Repository); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "repositoryId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	if _, err := globalid.DecodeAs(input.InviteeID, globalid.User); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "inviteeId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	invitation, err := r.Srv.InviteCollaborator(ctx, input.RepositoryID, input.InviteeID, input.Permission)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "inviteeId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	payload.Invitation = invitation
+	return payload, nil
+}
+
+// AcceptRepositoryInvitation is the resolver for the acceptRepositoryInvitation field.
+func (r *mutationResolver) AcceptRepositoryInvitation(ctx context.Context, input model.AcceptRepositoryInvitationInput) (*model.AcceptRepositoryInvitationPayload, error) {
+	payload := &model.AcceptRepositoryInvitationPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if _, err := globalid.DecodeAs(input.InvitationID, globalid.RepositoryInvitation); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "invitationId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	repo, err := r.Srv.AcceptRepositoryInvitation(ctx, input.InvitationID)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "invitationId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
 	}
+
+	payload.Repository = repo
+	return payload, nil
+}
+
+// DeclineRepositoryInvitation is the resolver for the declineRepositoryInvitation field.
+func (r *mutationResolver) DeclineRepositoryInvitation(ctx context.Context, input model.DeclineRepositoryInvitationInput) (*model.DeclineRepositoryInvitationPayload, error) {
+	payload := &model.DeclineRepositoryInvitationPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if _, err := globalid.DecodeAs(input.InvitationID, globalid.RepositoryInvitation); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "invitationId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	if err := r.Srv.DeclineRepositoryInvitation(ctx, input.InvitationID); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "invitationId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	payload.DeclinedInvitationID = &input.InvitationID
+	return payload, nil
+}
+
+// SignUp is the resolver for the signUp field.
+func (r *mutationResolver) SignUp(ctx context.Context, input model.SignUpInput) (*model.SignUpPayload, error) {
+	payload := &model.SignUpPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if err := validateName(input.Name); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "name"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	if err := validatePassword(input.Password); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "password"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+
+	if _, err := r.Srv.SignUp(ctx, input.Name, input.Password); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "name"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	// 登録したらそのままログインさせる
+	user, session, err := r.Srv.Login(ctx, input.Name, input.Password)
+	if err != nil {
+		return nil, err
+	}
+	auth.SetSessionCookie(ctx, session)
+
+	payload.User = user
+	payload.CsrfToken = &session.CSRFToken
+	return payload, nil
+}
+
+// Login is the resolver for the login field.
+func (r *mutationResolver) Login(ctx context.Context, input model.LoginInput) (*model.LoginPayload, error) {
+	payload := &model.LoginPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	user, session, err := r.Srv.Login(ctx, input.Name, input.Password)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	auth.SetSessionCookie(ctx, session)
+
+	payload.User = user
+	payload.CsrfToken = &session.CSRFToken
+	return payload, nil
+}
+
+// Logout is the resolver for the logout field.
+func (r *mutationResolver) Logout(ctx context.Context, input model.LogoutInput) (*model.LogoutPayload, error) {
+	payload := &model.LogoutPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	// トークンで認証した場合は削除するセッションが無いので、何もしない
+	session, ok := auth.GetSession(ctx)
+	if !ok {
+		return payload, nil
+	}
+	if err := r.Srv.Logout(ctx, session.Token); err != nil {
+		if payload.UserErrors, err = userErrors(err); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	auth.ClearSessionCookie(ctx)
+	return payload, nil
+}
+
+// BlockUser is the resolver for the blockUser field.
+func (r *mutationResolver) BlockUser(ctx context.Context, input model.BlockUserInput) (*model.BlockUserPayload, error) {
+	payload := &model.BlockUserPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if _, err := globalid.DecodeAs(input.UserID, globalid.User); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "userId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	user, err := r.Srv.BlockUser(ctx, input.UserID)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "userId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	payload.User = user
+	return payload, nil
+}
+
+// UnblockUser is the resolver for the unblockUser field.
+func (r *mutationResolver) UnblockUser(ctx context.Context, input model.UnblockUserInput) (*model.UnblockUserPayload, error) {
+	payload := &model.UnblockUserPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if _, err := globalid.DecodeAs(input.UserID, globalid.User); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "userId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	user, err := r.Srv.UnblockUser(ctx, input.UserID)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "userId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	payload.User = user
+	return payload, nil
+}
+
+// SetRepositoryInteractionLimit is the resolver for the setRepositoryInteractionLimit field.
+func (r *mutationResolver) SetRepositoryInteractionLimit(ctx context.Context, input model.SetRepositoryInteractionLimitInput) (*model.SetRepositoryInteractionLimitPayload, error) {
+	payload := &model.SetRepositoryInteractionLimitPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+
+	if _, err := globalid.DecodeAs(input.RepositoryID, globalid.Repository); err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "repositoryId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	expiry := model.RepositoryInteractionLimitExpiryOneDay
+	if input.Expiry != nil {
+		expiry = *input.Expiry
+	}
+	repo, err := r.Srv.SetRepositoryInteractionLimit(ctx, input.RepositoryID, input.Limit, expiry)
+	if err != nil {
+		if payload.UserErrors, err = userErrors(err, "input", "repositoryId"); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	}
+	payload.Repository = repo
+	return payload, nil
 }
 
 // Items is the resolver for the items field.
@@ -82,11 +586,12 @@ func (r *projectV2ItemResolver) Content(ctx context.Context, obj *model.ProjectV
 		return nil, err
 	}
 
+	// 読めないリポジトリのIssue・PullRequestはnullにする
 	switch content := item.Content.(type) {
 	case *model.Issue:
-		return r.Srv.GetIssueByID(ctx, content.ID)
+		return asContent(nullIfNotFound(r.Srv.GetIssueByID(ctx, content.ID)))
 	case *model.PullRequest:
-		return r.Srv.GetPullRequestByID(ctx, content.ID)
+		return asContent(nullIfNotFound(r.Srv.GetPullRequestByID(ctx, content.ID)))
 	default:
 		return nil, errors.New("invalid ProjectV2 Item")
 	}
@@ -106,35 +611,87 @@ func (r *pullRequestResolver) ProjectItems(ctx context.Context, obj *model.PullR
 func (r *queryResolver) Repository(ctx context.Context, name string, owner string) (*model.Repository, error) {
 	user, err := r.Srv.GetUserByName(ctx, owner)
 	if err != nil {
-		return nil, err
+		return nullIfNotFound[*model.Repository](nil, err)
 	}
-	return r.Srv.GetRepoByFullName(ctx, user.ID, name)
+	return nullIfNotFound(r.Srv.GetRepoByFullName(ctx, user.ID, name))
 }
 
 // User is the resolver for the user field.
 func (r *queryResolver) User(ctx context.Context, name string) (*model.User, error) {
-	return r.Srv.GetUserByName(ctx, name)
+	return nullIfNotFound(r.Srv.GetUserByName(ctx, name))
 }
 
 // Node is the resolver for the node field.
 func (r *queryResolver) Node(ctx context.Context, id string) (model.Node, error) {
-	nElems := strings.SplitN(id, "_", 2)
-	nType, _ := nElems[0], nElems[1]
-
-	switch nType {
-	case "U":
-		return r.Srv.GetUserByID(ctx, id)
-	case "REPO":
-		return r.Srv.GetRepoByID(ctx, id)
-	case "ISSUE":
-		return r.Srv.GetIssueByID(ctx, id)
-	case "PJ":
-		return r.Srv.GetProjectByID(ctx, id)
-	case "PR":
-		return r.Srv.GetPullRequestByID(ctx, id)
-	default:
-		return nil, errors.New("invalid ID")
+	return nullIfNotFound(r.getNode(ctx, id))
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]model.Node, error) {
+	if limit := r.maxPageSize(); len(ids) > limit {
+		return nil, apperrors.New(apperrors.Validation, "requesting %d nodes exceeds the limit of %d", len(ids), limit)
+	}
+
+	// 1. 全てのIDを型ごとのLoaderに登録してから待つことで、型ごとに1回のINクエリにまとめる
+	thunks := make([]nodeThunk, len(ids))
+	for i, id := range ids {
+		thunks[i] = r.loadNode(ctx, id)
+	}
+
+	// 2. 見つからなかったIDの位置はnullのままにする
+	nodes := make([]model.Node, len(ids))
+	for i, thunk := range thunks {
+		if thunk == nil {
+			continue
+		}
+		node, err := thunk()
+		if err != nil {
+			if !errors.Is(err, errNodeNotFound) {
+				graphql.AddError(ctx, err)
+			}
+			continue
+		}
+		nodes[i] = node
+	}
+	return nodes, nil
+}
+
+// RateLimit is the resolver for the rateLimit field.
+func (r *queryResolver) RateLimit(ctx context.Context) (*model.RateLimit, error) {
+	// レート制限が有効でない場合はnullを返す
+	status := ratelimit.GetStatus(ctx)
+	if status == nil {
+		return nil, nil
+	}
+	return &model.RateLimit{
+		Cost:      status.Cost,
+		Limit:     status.Limit,
+		Remaining: status.Remaining,
+		ResetAt:   status.ResetAt,
+	}, nil
+}
+
+// RepositoryInvitations is the resolver for the repositoryInvitations field.
+func (r *queryResolver) RepositoryInvitations(ctx context.Context) ([]*model.RepositoryInvitation, error) {
+	return r.Srv.ListViewerRepositoryInvitations(ctx)
+}
+
+// AuditLog is the resolver for the auditLog field.
+func (r *queryResolver) AuditLog(ctx context.Context, filter *model.AuditLogFilter, first *int, after *string) (*model.AuditLogEntryConnection, error) {
+	if filter != nil {
+		if filter.ActorID != nil {
+			if _, err := globalid.DecodeAs(*filter.ActorID, globalid.User); err != nil {
+				return nil, err
+			}
+		}
+		// nodeIdは、どの型のノードでもよい
+		if filter.NodeID != nil {
+			if _, err := globalid.Decode(*filter.NodeID); err != nil {
+				return nil, err
+			}
+		}
 	}
+	return r.Srv.ListAuditLog(ctx, filter, after, first)
 }
 
 // Owner is the resolver for the owner field.
@@ -142,9 +699,23 @@ func (r *repositoryResolver) Owner(ctx context.Context, obj *model.Repository) (
 	return r.Srv.GetUserByID(ctx, obj.Owner.ID)
 }
 
+// ViewerPermission is the resolver for the viewerPermission field.
+func (r *repositoryResolver) ViewerPermission(ctx context.Context, obj *model.Repository) (*model.RepositoryPermission, error) {
+	permission, err := r.Srv.GetViewerPermission(ctx, obj.ID)
+	if err != nil || permission == "" {
+		return nil, err
+	}
+	return &permission, nil
+}
+
+// InteractionAbility is the resolver for the interactionAbility field.
+func (r *repositoryResolver) InteractionAbility(ctx context.Context, obj *model.Repository) (*model.RepositoryInteractionAbility, error) {
+	return r.Srv.GetRepositoryInteractionAbility(ctx, obj.ID)
+}
+
 // Issue is the resolver for the issue field.
 func (r *repositoryResolver) Issue(ctx context.Context, obj *model.Repository, number int) (*model.Issue, error) {
-	return r.Srv.GetIssueByRepoAndNumber(ctx, obj.ID, number)
+	return nullIfNotFound(r.Srv.GetIssueByRepoAndNumber(ctx, obj.ID, number))
 }
 
 // Issues is the resolver for the issues field.
@@ -154,7 +725,7 @@ func (r *repositoryResolver) Issues(ctx context.Context, obj *model.Repository,
 
 // PullRequest is the resolver for the pullRequest field.
 func (r *repositoryResolver) PullRequest(ctx context.Context, obj *model.Repository, number int) (*model.PullRequest, error) {
-	return r.Srv.GetPullRequestByRepoAndNumber(ctx, obj.ID, number)
+	return nullIfNotFound(r.Srv.GetPullRequestByRepoAndNumber(ctx, obj.ID, number))
 }
 
 // PullRequests is the resolver for the pullRequests field.
@@ -162,9 +733,89 @@ func (r *repositoryResolver) PullRequests(ctx context.Context, obj *model.Reposi
 	return r.Srv.ListPullRequestInRepository(ctx, obj.ID, after, before, first, last)
 }
 
+// Invitee is the resolver for the invitee field.
+func (r *repositoryInvitationResolver) Invitee(ctx context.Context, obj *model.RepositoryInvitation) (*model.User, error) {
+	return r.loaders(ctx).UserLoader.Load(ctx, obj.Invitee.ID)()
+}
+
+// Inviter is the resolver for the inviter field.
+func (r *repositoryInvitationResolver) Inviter(ctx context.Context, obj *model.RepositoryInvitation) (*model.User, error) {
+	return r.loaders(ctx).UserLoader.Load(ctx, obj.Inviter.ID)()
+}
+
+// ProjectV2ItemAdded is the resolver for the projectV2ItemAdded field.
+func (r *subscriptionResolver) ProjectV2ItemAdded(ctx context.Context, projectID string) (<-chan *model.ProjectV2Item, error) {
+	return subscribeProjectItems(ctx, r.Resolver, projectID, services.ProjectItemAdded, func(item *model.ProjectV2Item) *model.ProjectV2Item {
+		return item
+	})
+}
+
+// ProjectV2ItemRemoved is the resolver for the projectV2ItemRemoved field.
+func (r *subscriptionResolver) ProjectV2ItemRemoved(ctx context.Context, projectID string) (<-chan string, error) {
+	return subscribeProjectItems(ctx, r.Resolver, projectID, services.ProjectItemRemoved, func(item *model.ProjectV2Item) string {
+		return item.ID
+	})
+}
+
+// ProjectV2ItemUpdated is the resolver for the projectV2ItemUpdated field.
+func (r *subscriptionResolver) ProjectV2ItemUpdated(ctx context.Context, projectID string) (<-chan *model.ProjectV2Item, error) {
+	return subscribeProjectItems(ctx, r.Resolver, projectID, services.ProjectItemUpdated, func(item *model.ProjectV2Item) *model.ProjectV2Item {
+		return item
+	})
+}
+
+// IssueChanged is the resolver for the issueChanged field.
+func (r *subscriptionResolver) IssueChanged(ctx context.Context, repositoryID string) (<-chan *model.IssueChangedEvent, error) {
+	if err := r.validateSubscribedRepository(ctx, repositoryID); err != nil {
+		return nil, err
+	}
+
+	events := r.Srv.SubscribeIssueEvents(ctx, repositoryID)
+	return forwardEvents(ctx, events, func(event *services.IssueEvent) (*model.IssueChangedEvent, bool) {
+		if !stillReadable(ctx, func(ctx context.Context, id string) error {
+			_, err := r.Srv.GetIssueByID(ctx, id)
+			return err
+		}, event.Issue.ID) {
+			return nil, false
+		}
+		return &model.IssueChangedEvent{
+			Action:        changeActions[event.Action],
+			ChangedFields: event.ChangedFields,
+			Issue:         event.Issue,
+		}, true
+	}), nil
+}
+
+// PullRequestChanged is the resolver for the pullRequestChanged field.
+func (r *subscriptionResolver) PullRequestChanged(ctx context.Context, repositoryID string) (<-chan *model.PullRequestChangedEvent, error) {
+	if err := r.validateSubscribedRepository(ctx, repositoryID); err != nil {
+		return nil, err
+	}
+
+	events := r.Srv.SubscribePullRequestEvents(ctx, repositoryID)
+	return forwardEvents(ctx, events, func(event *services.PullRequestEvent) (*model.PullRequestChangedEvent, bool) {
+		if !stillReadable(ctx, func(ctx context.Context, id string) error {
+			_, err := r.Srv.GetPullRequestByID(ctx, id)
+			return err
+		}, event.PullRequest.ID) {
+			return nil, false
+		}
+		return &model.PullRequestChangedEvent{
+			Action:        changeActions[event.Action],
+			ChangedFields: event.ChangedFields,
+			PullRequest:   event.PullRequest,
+		}, true
+	}), nil
+}
+
+// IsBlockedByViewer is the resolver for the isBlockedByViewer field.
+func (r *userResolver) IsBlockedByViewer(ctx context.Context, obj *model.User) (bool, error) {
+	return r.Srv.IsBlockedByViewer(ctx, obj.ID)
+}
+
 // ProjectV2 is the resolver for the projectV2 field.
 func (r *userResolver) ProjectV2(ctx context.Context, obj *model.User, number int) (*model.ProjectV2, error) {
-	return r.Srv.GetProjectByOwnerAndNumber(ctx, obj.ID, number)
+	return nullIfNotFound(r.Srv.GetProjectByOwnerAndNumber(ctx, obj.ID, number))
 }
 
 // ProjectV2s is the resolver for the projectV2s field.
@@ -172,6 +823,9 @@ func (r *userResolver) ProjectV2s(ctx context.Context, obj *model.User, after *s
 	return r.Srv.ListProjectByOwner(ctx, obj.ID, after, before, first, last)
 }
 
+// AuditLogEntry returns internal.AuditLogEntryResolver implementation.
+func (r *Resolver) AuditLogEntry() internal.AuditLogEntryResolver { return &auditLogEntryResolver{r} }
+
 // Issue returns internal.IssueResolver implementation.
 func (r *Resolver) Issue() internal.IssueResolver { return &issueResolver{r} }
 
@@ -193,9 +847,18 @@ func (r *Resolver) Query() internal.QueryResolver { return &queryResolver{r} }
 // Repository returns internal.RepositoryResolver implementation.
 func (r *Resolver) Repository() internal.RepositoryResolver { return &repositoryResolver{r} }
 
+// RepositoryInvitation returns internal.RepositoryInvitationResolver implementation.
+func (r *Resolver) RepositoryInvitation() internal.RepositoryInvitationResolver {
+	return &repositoryInvitationResolver{r}
+}
+
+// Subscription returns internal.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() internal.SubscriptionResolver { return &subscriptionResolver{r} }
+
 // User returns internal.UserResolver implementation.
 func (r *Resolver) User() internal.UserResolver { return &userResolver{r} }
 
+type auditLogEntryResolver struct{ *Resolver }
 type issueResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type projectV2Resolver struct{ *Resolver }
@@ -203,4 +866,6 @@ type projectV2ItemResolver struct{ *Resolver }
 type pullRequestResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type repositoryResolver struct{ *Resolver }
+type repositoryInvitationResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
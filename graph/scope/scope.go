@@ -0,0 +1,103 @@
+// Package scope は、トークンに与える権限の範囲(スコープ)を扱う
+package scope
+
+import (
+	"strings"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+)
+
+type Scope string
+
+const (
+	// RepoRead は、リポジトリとその中のissue/pull requestを読める
+	RepoRead Scope = "repo:read"
+	// RepoWrite は、RepoReadに加えてissue/pull requestを作成・更新できる
+	RepoWrite Scope = "repo:write"
+	// Project は、プロジェクトのアイテムを追加・削除・アーカイブできる
+	Project Scope = "project"
+	// User は、ユーザー自身の設定(トークンなど)を管理できる
+	User Scope = "user"
+)
+
+// All は、定義されている全てのスコープ
+var All = []Scope{RepoRead, RepoWrite, Project, User}
+
+// implied は、あるスコープを持っていれば暗黙に与えられるスコープ
+var implied = map[Scope][]Scope{
+	RepoWrite: {RepoRead},
+}
+
+func (s Scope) valid() bool {
+	for _, v := range All {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Set は、トークンに与えられたスコープの集合
+type Set []Scope
+
+// Parse は、文字列のスコープを検証して重複を除いたSetにする
+func Parse(scopes []string) (Set, error) {
+	var set Set
+	for _, s := range scopes {
+		if !Scope(s).valid() {
+			return nil, apperrors.New(apperrors.Validation, "unknown scope %q", s)
+		}
+		if !set.contains(Scope(s)) {
+			set = append(set, Scope(s))
+		}
+	}
+	return set, nil
+}
+
+func (set Set) contains(s Scope) bool {
+	for _, v := range set {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Has は、sを直接または暗黙に持っているかを返す
+func (set Set) Has(s Scope) bool {
+	for _, v := range set {
+		if v == s {
+			return true
+		}
+		for _, i := range implied[v] {
+			if i == s {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Strings は、GraphQLのレスポンスに使う文字列のスライスにする
+func (set Set) Strings() []string {
+	result := make([]string, 0, len(set))
+	for _, s := range set {
+		result = append(result, string(s))
+	}
+	return result
+}
+
+// String は、DBに保存する空白区切りの形にする
+func (set Set) String() string {
+	return strings.Join(set.Strings(), " ")
+}
+
+// FromString は、DBに保存された空白区切りのスコープを読む
+// 保存時に検証しているので、ここでは検証しない
+func FromString(s string) Set {
+	var set Set
+	for _, v := range strings.Fields(s) {
+		set = append(set, Scope(v))
+	}
+	return set
+}
@@ -0,0 +1,52 @@
+package scope_test
+
+import (
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	set, err := scope.Parse([]string{"repo:read", "project", "repo:read"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(scope.Set{scope.RepoRead, scope.Project}, set); diff != "" {
+		t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := scope.Parse([]string{"admin"}); apperrors.From(err).Code != apperrors.Validation {
+		t.Errorf("want validation error, but got %v", err)
+	}
+}
+
+func TestHas(t *testing.T) {
+	tests := []struct {
+		name string
+		set  scope.Set
+		want scope.Scope
+		has  bool
+	}{
+		{name: "direct", set: scope.Set{scope.Project}, want: scope.Project, has: true},
+		{name: "write implies read", set: scope.Set{scope.RepoWrite}, want: scope.RepoRead, has: true},
+		{name: "read does not imply write", set: scope.Set{scope.RepoRead}, want: scope.RepoWrite, has: false},
+		{name: "empty", set: nil, want: scope.User, has: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.Has(tt.want); got != tt.has {
+				t.Errorf("Has(%s) = %v, want %v", tt.want, got, tt.has)
+			}
+		})
+	}
+}
+
+func TestStringRoundTrip(t *testing.T) {
+	set := scope.Set{scope.RepoWrite, scope.User}
+	if diff := cmp.Diff(set, scope.FromString(set.String())); diff != "" {
+		t.Errorf("FromString(String()) mismatch (-want +got):\n%s", diff)
+	}
+}
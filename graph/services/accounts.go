@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/db"
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// DefaultSessionTTL は、ログインしてからセッションが切れるまでの時間のデフォルト値
+const DefaultSessionTTL = 14 * 24 * time.Hour
+
+type accountService struct {
+	exec       boil.ContextExecutor
+	now        func() time.Time
+	sessionTTL time.Duration
+}
+
+// dummyPasswordHash は、存在しないユーザーでのログインでもパスワードの照合にかかる時間を同じにするためのもの
+// 応答時間の差からユーザー名の有無を調べられないようにする
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+
+var errInvalidCredentials = apperrors.New(apperrors.Unauthenticated, "invalid name or password")
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// SignUp は、パスワードをbcryptでハッシュにして保存し、ユーザーを作成する
+// ソルトはbcryptがハッシュごとに生成してハッシュの中に持つ
+func (a *accountService) SignUp(ctx context.Context, name, password string) (*model.User, error) {
+	if err := policy.Authorize(policy.UserSignUp, facts(ctx, "", "")); err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &db.User{
+		ID:           globalid.Encode(globalid.User, uuid.New().String()),
+		Name:         name,
+		PasswordHash: null.StringFrom(string(hash)),
+	}
+	if err := user.Insert(ctx, a.exec, boil.Infer()); err != nil {
+		if apperrors.From(err).Code == apperrors.Conflict {
+			return nil, apperrors.New(apperrors.Conflict, "name %q is already taken", name)
+		}
+		return nil, err
+	}
+	return convertUser(user), nil
+}
+
+// Login は、名前とパスワードを確かめてセッションを発行する
+// 名前とパスワードのどちらが間違っているかは区別しない
+func (a *accountService) Login(ctx context.Context, name, password string) (*model.User, *auth.Session, error) {
+	if err := policy.Authorize(policy.SessionCreate, facts(ctx, "", "")); err != nil {
+		return nil, nil, err
+	}
+	user, err := db.Users(db.UserWhere.Name.EQ(name)).One(ctx, a.exec)
+	if err != nil && !apperrors.IsNotFound(err) {
+		return nil, nil, err
+	}
+	if user == nil || !user.PasswordHash.Valid {
+		bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(password))
+		return nil, nil, errInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash.String), []byte(password)); err != nil {
+		return nil, nil, errInvalidCredentials
+	}
+
+	session, err := a.createSession(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return convertUser(user), session, nil
+}
+
+func (a *accountService) createSession(ctx context.Context, userID string) (*auth.Session, error) {
+	token, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	csrfToken, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := a.now()
+	row := &db.Session{
+		ID:        uuid.New().String(),
+		Owner:     userID,
+		TokenHash: hashPersonalAccessToken(token),
+		CSRFToken: csrfToken,
+		CreatedAt: now,
+		ExpiresAt: now.Add(a.sessionTTL),
+	}
+	if err := row.Insert(ctx, a.exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return &auth.Session{Token: token, CSRFToken: csrfToken, ExpiresAt: row.ExpiresAt}, nil
+}
+
+// Logout は、トークンのセッションを削除する。既に無いセッションに対しては何もしない
+func (a *accountService) Logout(ctx context.Context, token string) error {
+	session, err := db.Sessions(db.SessionWhere.TokenHash.EQ(hashPersonalAccessToken(token))).One(ctx, a.exec)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if err := policy.Authorize(policy.SessionDelete, facts(ctx, "", session.Owner)); err != nil {
+		return err
+	}
+	_, err = session.Delete(ctx, a.exec)
+	return err
+}
+
+// AuthenticateSession は、Cookieで送られたトークンのセッションとその持ち主を返す
+// 存在しない・期限切れのセッションは区別せずに拒否する
+func (a *accountService) AuthenticateSession(ctx context.Context, token string) (*model.User, *auth.Session, error) {
+	session, err := db.Sessions(
+		qm.Load(db.SessionRels.OwnerUser),
+		db.SessionWhere.TokenHash.EQ(hashPersonalAccessToken(token)),
+		db.SessionWhere.ExpiresAt.GT(a.now()),
+	).One(ctx, a.exec)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, nil, apperrors.New(apperrors.Unauthenticated, "invalid session")
+		}
+		return nil, nil, err
+	}
+	return convertUser(session.R.OwnerUser), &auth.Session{
+		Token:     token,
+		CSRFToken: session.CSRFToken,
+		ExpiresAt: session.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+)
+
+func TestSignUpAndLogin(t *testing.T) {
+	db := newTestDB(t)
+	srv := services.New(db)
+	ctx := context.Background()
+
+	user, err := srv.SignUp(ctx, "hsaki", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.SignUp(ctx, "hsaki", "another password"); apperrors.From(err).Code != apperrors.Conflict {
+		t.Errorf("want conflict, but got %v", err)
+	}
+
+	// パスワードは平文で保存しない
+	var hash string
+	if err := db.QueryRow(`SELECT password_hash FROM users WHERE id = ?`, user.ID).Scan(&hash); err != nil {
+		t.Fatal(err)
+	}
+	if hash == "correct horse" {
+		t.Error("password is stored in plain text")
+	}
+
+	// 名前とパスワードのどちらが間違っていても同じエラーを返す
+	for _, c := range []struct{ name, password string }{
+		{"hsaki", "wrong password"},
+		{"nobody", "correct horse"},
+	} {
+		_, _, err := srv.Login(ctx, c.name, c.password)
+		if appErr := apperrors.From(err); appErr.Code != apperrors.Unauthenticated || appErr.Message != "invalid name or password" {
+			t.Errorf("login(%s, %s): unexpected error %v", c.name, c.password, err)
+		}
+	}
+
+	viewer, session, err := srv.Login(ctx, "hsaki", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if viewer.ID != user.ID {
+		t.Errorf("logged in as %s, want %s", viewer.ID, user.ID)
+	}
+	if session.Token == "" || session.CSRFToken == "" || session.Token == session.CSRFToken {
+		t.Errorf("unexpected session: %+v", session)
+	}
+
+	authenticated, got, err := srv.AuthenticateSession(ctx, session.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authenticated.ID != user.ID || got.CSRFToken != session.CSRFToken {
+		t.Errorf("unexpected session: %+v, %+v", authenticated, got)
+	}
+}
+
+func TestLogout(t *testing.T) {
+	db := newTestDB(t)
+	srv := services.New(db)
+	ctx := context.Background()
+
+	user, err := srv.SignUp(ctx, "hsaki", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, session, err := srv.Login(ctx, "hsaki", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 他人のセッションは削除できない
+	if err := srv.Logout(as("U_2"), session.Token); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("want forbidden, but got %v", err)
+	}
+	if err := srv.Logout(as(user.ID), session.Token); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := srv.AuthenticateSession(ctx, session.Token); apperrors.From(err).Code != apperrors.Unauthenticated {
+		t.Errorf("want unauthenticated, but got %v", err)
+	}
+}
+
+func TestExpiredSessionIsRejected(t *testing.T) {
+	db := newTestDB(t)
+	srv := services.New(db)
+	ctx := context.Background()
+
+	if _, err := srv.SignUp(ctx, "hsaki", "correct horse"); err != nil {
+		t.Fatal(err)
+	}
+	_, session, err := srv.Login(ctx, "hsaki", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustExec(t, db, `UPDATE sessions SET expires_at = '2000-01-01 00:00:00'`)
+
+	if _, _, err := srv.AuthenticateSession(ctx, session.Token); apperrors.From(err).Code != apperrors.Unauthenticated {
+		t.Errorf("want unauthenticated, but got %v", err)
+	}
+}
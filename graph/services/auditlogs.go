@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/db"
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type auditLogService struct {
+	exec        boil.ContextExecutor
+	maxPageSize int
+	now         func() time.Time
+}
+
+func convertAuditLogEntry(entry *db.AuditLog) *model.AuditLogEntry {
+	result := &model.AuditLogEntry{
+		ID:         entry.ID,
+		Variables:  entry.Variables,
+		NodeIds:    []string{},
+		Outcome:    model.AuditLogOutcome(entry.Outcome),
+		DurationMs: int(entry.DurationMS),
+		CreatedAt:  entry.CreatedAt,
+	}
+	if entry.Actor.Valid {
+		result.Actor = &model.User{ID: entry.Actor.String}
+	}
+	if entry.Impersonator.Valid {
+		result.Impersonator = &model.User{ID: entry.Impersonator.String}
+	}
+	if entry.OperationName != "" {
+		result.OperationName = &entry.OperationName
+	}
+	if entry.R != nil {
+		for _, node := range entry.R.AuditLogNodes {
+			result.NodeIds = append(result.NodeIds, node.NodeID)
+		}
+		sort.Strings(result.NodeIds)
+	}
+	return result
+}
+
+func convertAuditLogEntryConnection(entries db.AuditLogSlice, hasPrevPage, hasNextPage bool) *model.AuditLogEntryConnection {
+	var result model.AuditLogEntryConnection
+
+	for _, dbe := range entries {
+		entry := convertAuditLogEntry(dbe)
+
+		result.Edges = append(result.Edges, &model.AuditLogEntryEdge{Cursor: entry.ID, Node: entry})
+		result.Nodes = append(result.Nodes, entry)
+	}
+	result.TotalCount = len(entries)
+
+	result.PageInfo = &model.PageInfo{}
+	if result.TotalCount != 0 {
+		result.PageInfo.StartCursor = &result.Nodes[0].ID
+		result.PageInfo.EndCursor = &result.Nodes[result.TotalCount-1].ID
+	}
+	result.PageInfo.HasPreviousPage = hasPrevPage
+	result.PageInfo.HasNextPage = hasNextPage
+
+	return &result
+}
+
+// RecordAuditLog は、実行されたmutationを記録する
+// IDは時刻順に並ぶUUIDv7から作るので、IDをカーソルにすると記録した順に読める
+// 記録を変更・削除する方法は用意せず、DBのトリガーでも禁止している
+func (a *auditLogService) RecordAuditLog(ctx context.Context, entry *model.AuditLogEntry) error {
+	key, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	row := &db.AuditLog{
+		ID:         globalid.Encode(globalid.AuditLogEntry, key.String()),
+		Variables:  entry.Variables,
+		Outcome:    entry.Outcome.String(),
+		DurationMS: int64(entry.DurationMs),
+		CreatedAt:  a.now().UTC(),
+	}
+	if entry.Actor != nil {
+		row.Actor = null.StringFrom(entry.Actor.ID)
+	}
+	if entry.Impersonator != nil {
+		row.Impersonator = null.StringFrom(entry.Impersonator.ID)
+	}
+	if entry.OperationName != nil {
+		row.OperationName = *entry.OperationName
+	}
+	nodes := make([]*db.AuditLogNode, 0, len(entry.NodeIds))
+	for _, id := range entry.NodeIds {
+		nodes = append(nodes, &db.AuditLogNode{NodeID: id})
+	}
+
+	// 記録とノードのIDは揃って残るようにする
+	exec, commit, rollback, err := beginTx(ctx, a.exec)
+	if err != nil {
+		return err
+	}
+	defer rollback()
+	if err := row.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+	if err := row.AddAuditLogNodes(ctx, exec, true, nodes...); err != nil {
+		return err
+	}
+	return commit()
+}
+
+func (a *auditLogService) GetAuditLogEntry(ctx context.Context, id string) (*model.AuditLogEntry, error) {
+	if err := policy.Authorize(policy.AuditLogRead, facts(ctx, "", "")); err != nil {
+		return nil, err
+	}
+	entry, err := db.AuditLogs(
+		qm.Load(db.AuditLogRels.AuditLogNodes),
+		db.AuditLogWhere.ID.EQ(id),
+	).One(ctx, a.exec)
+	if err != nil {
+		return nil, err
+	}
+	return convertAuditLogEntry(entry), nil
+}
+
+// ListAuditLog は、filterに合うmutationの記録を古い順に返す
+func (a *auditLogService) ListAuditLog(ctx context.Context, filter *model.AuditLogFilter, after *string, first *int) (*model.AuditLogEntryConnection, error) {
+	if err := policy.Authorize(policy.AuditLogRead, facts(ctx, "", "")); err != nil {
+		return nil, err
+	}
+
+	var mods []qm.QueryMod
+	if filter != nil {
+		if filter.ActorID != nil {
+			mods = append(mods, db.AuditLogWhere.Actor.EQ(null.StringFrom(*filter.ActorID)))
+		}
+		if filter.OperationName != nil {
+			mods = append(mods, db.AuditLogWhere.OperationName.EQ(*filter.OperationName))
+		}
+		if filter.Outcome != nil {
+			mods = append(mods, db.AuditLogWhere.Outcome.EQ(filter.Outcome.String()))
+		}
+		if filter.Since != nil {
+			mods = append(mods, db.AuditLogWhere.CreatedAt.GTE(filter.Since.UTC()))
+		}
+		if filter.Until != nil {
+			mods = append(mods, db.AuditLogWhere.CreatedAt.LT(filter.Until.UTC()))
+		}
+		if filter.NodeID != nil {
+			mods = append(mods, qm.Where(
+				"EXISTS (SELECT 1 FROM audit_log_nodes WHERE audit_log_nodes.audit_log = audit_logs.id AND audit_log_nodes.node_id = ?)",
+				*filter.NodeID,
+			))
+		}
+	}
+
+	entries, err := paginate(ctx, connectionQuery[*db.AuditLog]{
+		maxPageSize:  a.maxPageSize,
+		cursorColumn: db.AuditLogColumns.ID,
+		columns: []string{
+			db.AuditLogColumns.ID,
+			db.AuditLogColumns.Actor,
+			db.AuditLogColumns.Impersonator,
+			db.AuditLogColumns.OperationName,
+			db.AuditLogColumns.Variables,
+			db.AuditLogColumns.Outcome,
+			db.AuditLogColumns.DurationMS,
+			db.AuditLogColumns.CreatedAt,
+		},
+		filter: mods,
+		all: func(ctx context.Context, mods ...qm.QueryMod) ([]*db.AuditLog, error) {
+			return db.AuditLogs(append(mods, qm.Load(db.AuditLogRels.AuditLogNodes))...).All(ctx, a.exec)
+		},
+		exists: func(ctx context.Context, mods ...qm.QueryMod) (bool, error) {
+			return db.AuditLogs(mods...).Exists(ctx, a.exec)
+		},
+	}, after, nil, first, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertAuditLogEntryConnection(entries.rows, entries.hasPreviousPage, entries.hasNextPage), nil
+}
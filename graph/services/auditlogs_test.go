@@ -0,0 +1,121 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func siteAdmin() context.Context {
+	return auth.WithUser(context.Background(), &model.User{ID: "U_9", IsSiteAdmin: true}, scope.All)
+}
+
+func TestAuditLog(t *testing.T) {
+	db := newTestDB(t)
+	srv := services.New(db)
+	ctx := context.Background()
+
+	addItem, archive := "AddItem", "ArchiveItem"
+	entries := []*model.AuditLogEntry{
+		{Actor: &model.User{ID: "U_1"}, OperationName: &addItem, Variables: `{}`, NodeIds: []string{"PJ_1", "ISSUE_1", "PJI_1"}, Outcome: model.AuditLogOutcomeSuccess, DurationMs: 3},
+		{Actor: &model.User{ID: "U_2"}, OperationName: &addItem, Variables: `{}`, NodeIds: []string{"PJ_2", "ISSUE_1"}, Outcome: model.AuditLogOutcomeUserError},
+		{Actor: &model.User{ID: "U_1"}, Impersonator: &model.User{ID: "U_9"}, OperationName: &archive, Variables: `{}`, NodeIds: []string{"PJ_1", "PJI_1"}, Outcome: model.AuditLogOutcomeError},
+		{Variables: `{"input":{"password":"[REDACTED]"}}`, NodeIds: []string{}, Outcome: model.AuditLogOutcomeSuccess},
+	}
+	for _, entry := range entries {
+		if err := srv.RecordAuditLog(ctx, entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 記録した順に読める
+	first := 10
+	all, err := srv.ListAuditLog(siteAdmin(), nil, nil, &first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all.TotalCount != len(entries) {
+		t.Fatalf("want %d entries, but got %d", len(entries), all.TotalCount)
+	}
+	got := all.Nodes[2]
+	if got.Actor.ID != "U_1" || got.Impersonator.ID != "U_9" || *got.OperationName != archive || got.Outcome != model.AuditLogOutcomeError {
+		t.Errorf("unexpected entry: %+v", got)
+	}
+	if diff := cmp.Diff([]string{"PJI_1", "PJ_1"}, got.NodeIds); diff != "" {
+		t.Errorf("node ids mismatch (-want +got):\n%s", diff)
+	}
+	if anonymous := all.Nodes[3]; anonymous.Actor != nil || anonymous.OperationName != nil {
+		t.Errorf("unexpected entry: %+v", anonymous)
+	}
+
+	one := 1
+	page, err := srv.ListAuditLog(siteAdmin(), nil, &all.Nodes[0].ID, &one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Nodes[0].ID != all.Nodes[1].ID || !page.PageInfo.HasNextPage || !page.PageInfo.HasPreviousPage {
+		t.Errorf("unexpected page: %+v, %+v", page.Nodes[0], page.PageInfo)
+	}
+
+	// 誰がどのプロジェクトに何を追加したか
+	pj1, userErr := "PJ_1", model.AuditLogOutcomeUserError
+	tests := []struct {
+		name   string
+		filter *model.AuditLogFilter
+		want   []int
+	}{
+		{name: "node", filter: &model.AuditLogFilter{NodeID: &pj1}, want: []int{0, 2}},
+		{name: "node and operation", filter: &model.AuditLogFilter{NodeID: &pj1, OperationName: &addItem}, want: []int{0}},
+		{name: "actor", filter: &model.AuditLogFilter{ActorID: &entries[1].Actor.ID}, want: []int{1}},
+		{name: "outcome", filter: &model.AuditLogFilter{Outcome: &userErr}, want: []int{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, err := srv.ListAuditLog(siteAdmin(), tt.filter, nil, &first)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want, got []string
+			for _, i := range tt.want {
+				want = append(want, all.Nodes[i].ID)
+			}
+			for _, node := range conn.Nodes {
+				got = append(got, node.ID)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("entries mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := srv.ListAuditLog(as("U_1"), nil, nil, &first); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("want forbidden, but got %v", err)
+	}
+	if _, err := srv.GetAuditLogEntry(as("U_1"), all.Nodes[0].ID); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("want forbidden, but got %v", err)
+	}
+}
+
+func TestAuditLogIsAppendOnly(t *testing.T) {
+	db := newTestDB(t)
+	srv := services.New(db)
+	if err := srv.RecordAuditLog(context.Background(), &model.AuditLogEntry{Variables: `{}`, Outcome: model.AuditLogOutcomeSuccess}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, query := range []string{
+		`UPDATE audit_logs SET outcome = 'ERROR'`,
+		`DELETE FROM audit_logs`,
+	} {
+		if _, err := db.Exec(query); err == nil {
+			t.Errorf("%s should be rejected", query)
+		}
+	}
+}
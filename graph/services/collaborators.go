@@ -0,0 +1,195 @@
+package services
+
+import (
+	"context"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/db"
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// convertRepositoryInvitation は、招待先のリポジトリも合わせて変換する
+// 招待された人はまだリポジトリを読めないので、リポジトリはloaderを通さずに渡す
+func convertRepositoryInvitation(invitation *db.RepositoryInvitation) *model.RepositoryInvitation {
+	result := &model.RepositoryInvitation{
+		ID:         invitation.ID,
+		Repository: &model.Repository{ID: invitation.Repository},
+		Invitee:    &model.User{ID: invitation.Invitee},
+		Inviter:    &model.User{ID: invitation.Inviter},
+		Permission: model.RepositoryPermission(invitation.Permission),
+		CreatedAt:  invitation.CreatedAt,
+	}
+	if invitation.R != nil && invitation.R.RepositoryInvitationRepository != nil {
+		result.Repository = convertRepository(invitation.R.RepositoryInvitationRepository)
+	}
+	return result
+}
+
+func convertRepositoryInvitationSlice(invitations db.RepositoryInvitationSlice) []*model.RepositoryInvitation {
+	result := make([]*model.RepositoryInvitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		result = append(result, convertRepositoryInvitation(invitation))
+	}
+	return result
+}
+
+// GetRepositoryInvitation は、policy.RepositoryInvitationViewを満たす人にだけ招待を見せる
+func (r *repoService) GetRepositoryInvitation(ctx context.Context, id string) (*model.RepositoryInvitation, error) {
+	invitation, err := db.RepositoryInvitations(
+		qm.Load(db.RepositoryInvitationRels.RepositoryInvitationRepository),
+		db.RepositoryInvitationWhere.ID.EQ(id),
+	).One(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	permission, err := viewerPermission(ctx, r.exec, invitation.Repository)
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.Authorize(policy.RepositoryInvitationView, facts(ctx, permission, invitation.Invitee)); err != nil {
+		return nil, apperrors.New(apperrors.NotFound, "invitation not found")
+	}
+	return convertRepositoryInvitation(invitation), nil
+}
+
+// ListViewerRepositoryInvitations は、ctxのユーザーが受け取った招待を古い順に返す
+func (r *repoService) ListViewerRepositoryInvitations(ctx context.Context) ([]*model.RepositoryInvitation, error) {
+	viewer, ok := auth.GetUser(ctx)
+	if !ok {
+		return nil, apperrors.New(apperrors.Unauthenticated, "not authenticated")
+	}
+	invitations, err := db.RepositoryInvitations(
+		qm.Load(db.RepositoryInvitationRels.RepositoryInvitationRepository),
+		db.RepositoryInvitationWhere.Invitee.EQ(viewer.ID),
+		qm.OrderBy(db.RepositoryInvitationColumns.CreatedAt+", "+db.RepositoryInvitationColumns.ID),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	return convertRepositoryInvitationSlice(invitations), nil
+}
+
+// InviteCollaborator は、inviteeIDのユーザーをpermissionの権限でリポジトリに招待する
+// 招待できるかはpolicy.RepositoryInviteで決め、同じユーザーへの招待は上書きする
+func (r *repoService) InviteCollaborator(ctx context.Context, repoID, inviteeID string, permission model.RepositoryPermission) (*model.RepositoryInvitation, error) {
+	viewer, ok := auth.GetUser(ctx)
+	if !ok {
+		return nil, apperrors.New(apperrors.Unauthenticated, "not authenticated")
+	}
+	if !permission.IsValid() {
+		return nil, apperrors.New(apperrors.Validation, "invalid permission %q", permission)
+	}
+	if err := authorize(ctx, r.exec, policy.RepositoryInvite, repoID, ""); err != nil {
+		return nil, err
+	}
+
+	repo, err := db.FindRepository(ctx, r.exec, repoID)
+	if err != nil {
+		return nil, err
+	}
+	if repo.Owner == inviteeID {
+		return nil, apperrors.New(apperrors.Validation, "the owner of the repository cannot be invited")
+	}
+	if exists, err := db.UserExists(ctx, r.exec, inviteeID); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, apperrors.New(apperrors.NotFound, "user not found")
+	}
+	if blocked, err := isBlocked(ctx, r.exec, repo.Owner, inviteeID); err != nil {
+		return nil, err
+	} else if blocked {
+		return nil, apperrors.New(apperrors.Validation, "the user is blocked by the owner of the repository")
+	}
+	if exists, err := db.CollaboratorExists(ctx, r.exec, repoID, inviteeID); err != nil {
+		return nil, err
+	} else if exists {
+		return nil, apperrors.New(apperrors.Conflict, "the user is already a collaborator")
+	}
+
+	invitation, err := db.RepositoryInvitations(
+		db.RepositoryInvitationWhere.Repository.EQ(repoID),
+		db.RepositoryInvitationWhere.Invitee.EQ(inviteeID),
+	).One(ctx, r.exec)
+	switch {
+	case err == nil:
+		invitation.Inviter = viewer.ID
+		invitation.Permission = permission.String()
+		if _, err := invitation.Update(ctx, r.exec, boil.Whitelist(
+			db.RepositoryInvitationColumns.Inviter,
+			db.RepositoryInvitationColumns.Permission,
+		)); err != nil {
+			return nil, err
+		}
+	case apperrors.IsNotFound(err):
+		invitation = &db.RepositoryInvitation{
+			ID:         globalid.Encode(globalid.RepositoryInvitation, uuid.New().String()),
+			Repository: repoID,
+			Invitee:    inviteeID,
+			Inviter:    viewer.ID,
+			Permission: permission.String(),
+		}
+		if err := invitation.Insert(ctx, r.exec, boil.Infer()); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+	return r.GetRepositoryInvitation(ctx, invitation.ID)
+}
+
+// getRespondableInvitation は、policy.RepositoryInvitationRespondを満たす招待だけを取得する
+// 応じられない招待は存在しないものとして扱う
+func (r *repoService) getRespondableInvitation(ctx context.Context, id string) (*db.RepositoryInvitation, error) {
+	invitation, err := db.FindRepositoryInvitation(ctx, r.exec, id)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, apperrors.New(apperrors.NotFound, "invitation not found")
+		}
+		return nil, err
+	}
+	if err := policy.Authorize(policy.RepositoryInvitationRespond, facts(ctx, "", invitation.Invitee)); err != nil {
+		return nil, apperrors.New(apperrors.NotFound, "invitation not found")
+	}
+	return invitation, nil
+}
+
+// AcceptRepositoryInvitation は、招待を受けてcollaboratorになり、招待されたリポジトリを返す
+func (r *repoService) AcceptRepositoryInvitation(ctx context.Context, id string) (*model.Repository, error) {
+	invitation, err := r.getRespondableInvitation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	collaborator := &db.Collaborator{
+		Repository:   invitation.Repository,
+		Collaborator: invitation.Invitee,
+		Permission:   invitation.Permission,
+	}
+	if err := collaborator.Upsert(ctx, r.exec, true,
+		[]string{db.CollaboratorColumns.Repository, db.CollaboratorColumns.Collaborator},
+		boil.Whitelist(db.CollaboratorColumns.Permission),
+		boil.Infer(),
+	); err != nil {
+		return nil, err
+	}
+	if _, err := invitation.Delete(ctx, r.exec); err != nil {
+		return nil, err
+	}
+	return r.GetRepoByID(ctx, invitation.Repository)
+}
+
+// DeclineRepositoryInvitation は、招待を断って削除する
+func (r *repoService) DeclineRepositoryInvitation(ctx context.Context, id string) error {
+	invitation, err := r.getRespondableInvitation(ctx, id)
+	if err != nil {
+		return err
+	}
+	_, err = invitation.Delete(ctx, r.exec)
+	return err
+}
@@ -0,0 +1,176 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+)
+
+// newPrivateRepoDB は、U_1が持つ非公開リポジトリREPO_P とそのIssue・PullRequestを用意する
+func newPrivateRepoDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db := newTestDB(t)
+	mustExec(t, db, `INSERT INTO users(id, name) VALUES ('U_1', 'hsaki'), ('U_2', 'octocat'), ('U_3', 'stranger')`)
+	mustExec(t, db, `INSERT INTO repositories(id, owner, name, visibility) VALUES ('REPO_P', 'U_1', 'secret', 'PRIVATE')`)
+	mustExec(t, db, `INSERT INTO issues(id, url, title, number, author, repository) VALUES ('ISSUE_P', '', 'secret issue', 1, 'U_1', 'REPO_P')`)
+	mustExec(t, db, `INSERT INTO pullrequests(id, base_ref_name, head_ref_name, url, number, repository) VALUES ('PR_P', 'main', 'feature', '', 1, 'REPO_P')`)
+	return db
+}
+
+func as(userID string) context.Context {
+	return auth.WithUser(context.Background(), &model.User{ID: userID}, scope.All)
+}
+
+func TestPrivateRepositoryIsHidden(t *testing.T) {
+	srv := services.New(newPrivateRepoDB(t))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		visible bool
+	}{
+		{name: "anonymous", ctx: context.Background()},
+		{name: "stranger", ctx: as("U_3")},
+		{name: "owner", ctx: as("U_1"), visible: true},
+		// repo:readの無いトークンでは、持ち主でも非公開リポジトリは読めない
+		{name: "owner without repo:read", ctx: auth.WithUser(context.Background(), &model.User{ID: "U_1"}, scope.Set{scope.User, scope.Project})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks := map[string]error{}
+			_, checks["GetRepoByID"] = srv.GetRepoByID(tt.ctx, "REPO_P")
+			_, checks["GetRepoByFullName"] = srv.GetRepoByFullName(tt.ctx, "U_1", "secret")
+			_, checks["GetIssueByID"] = srv.GetIssueByID(tt.ctx, "ISSUE_P")
+			_, checks["GetIssueByRepoAndNumber"] = srv.GetIssueByRepoAndNumber(tt.ctx, "REPO_P", 1)
+			_, checks["GetPullRequestByID"] = srv.GetPullRequestByID(tt.ctx, "PR_P")
+			for name, err := range checks {
+				if tt.visible && err != nil {
+					t.Errorf("%s: %v", name, err)
+				}
+				if !tt.visible && !apperrors.IsNotFound(err) {
+					t.Errorf("%s: want not found, but got %v", name, err)
+				}
+			}
+
+			want := 0
+			if tt.visible {
+				want = 1
+			}
+			repos, err := srv.ListReposByID(tt.ctx, []string{"REPO_P"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			issues, err := srv.ListIssuesByID(tt.ctx, []string{"ISSUE_P"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			prs, err := srv.ListPullRequestsByID(tt.ctx, []string{"PR_P"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			first := 10
+			conn, err := srv.ListIssueInRepository(tt.ctx, "REPO_P", nil, nil, &first, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(repos) != want || len(issues) != want || len(prs) != want || conn.TotalCount != want {
+				t.Errorf("want %d rows, but got repos=%d issues=%d pullRequests=%d connection=%d", want, len(repos), len(issues), len(prs), conn.TotalCount)
+			}
+		})
+	}
+}
+
+func TestRepositoryInvitation(t *testing.T) {
+	srv := services.New(newPrivateRepoDB(t))
+	owner, invitee, stranger := as("U_1"), as("U_2"), as("U_3")
+
+	// ADMINでなければ招待できない
+	if _, err := srv.InviteCollaborator(stranger, "REPO_P", "U_2", model.RepositoryPermissionRead); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+	if _, err := srv.InviteCollaborator(owner, "REPO_P", "U_1", model.RepositoryPermissionRead); apperrors.From(err).Code != apperrors.Validation {
+		t.Errorf("want validation error, but got %v", err)
+	}
+
+	invitation, err := srv.InviteCollaborator(owner, "REPO_P", "U_2", model.RepositoryPermissionTriage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 招待された人は、まだリポジトリを読めないが招待は見える
+	if _, err := srv.GetRepoByID(invitee, "REPO_P"); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found before accepting, but got %v", err)
+	}
+	invitations, err := srv.ListViewerRepositoryInvitations(invitee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invitations) != 1 || invitations[0].Repository.Name != "secret" {
+		t.Errorf("unexpected invitations: %+v", invitations)
+	}
+	if _, err := srv.GetRepositoryInvitation(stranger, invitation.ID); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+	if _, err := srv.AcceptRepositoryInvitation(stranger, invitation.ID); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+
+	repo, err := srv.AcceptRepositoryInvitation(invitee, invitation.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.ID != "REPO_P" {
+		t.Errorf("unexpected repository: %+v", repo)
+	}
+	if permission, err := srv.GetViewerPermission(invitee, "REPO_P"); err != nil || permission != model.RepositoryPermissionTriage {
+		t.Errorf("want TRIAGE, but got %q (%v)", permission, err)
+	}
+	if _, err := srv.GetRepositoryInvitation(invitee, invitation.ID); !apperrors.IsNotFound(err) {
+		t.Errorf("accepted invitation should be deleted: %v", err)
+	}
+	if _, err := srv.InviteCollaborator(owner, "REPO_P", "U_2", model.RepositoryPermissionWrite); apperrors.From(err).Code != apperrors.Conflict {
+		t.Errorf("want conflict, but got %v", err)
+	}
+
+	// TRIAGEではIssueは更新できるが、PullRequestは更新できない
+	closed := true
+	if _, err := srv.UpdateIssue(invitee, "ISSUE_P", nil, &closed); err != nil {
+		t.Errorf("TRIAGE should be able to update issues: %v", err)
+	}
+	if _, err := srv.UpdatePullRequest(invitee, "PR_P", nil, &closed); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("want forbidden, but got %v", err)
+	}
+	if _, err := srv.UpdateIssue(stranger, "ISSUE_P", nil, &closed); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+	if _, err := srv.CreateIssue(stranger, "REPO_P", "U_3", "spam"); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+}
+
+func TestDeclineRepositoryInvitation(t *testing.T) {
+	srv := services.New(newPrivateRepoDB(t))
+
+	invitation, err := srv.InviteCollaborator(as("U_1"), "REPO_P", "U_2", model.RepositoryPermissionRead)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.DeclineRepositoryInvitation(as("U_3"), invitation.ID); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+	if err := srv.DeclineRepositoryInvitation(as("U_2"), invitation.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.GetRepoByID(as("U_2"), "REPO_P"); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found after declining, but got %v", err)
+	}
+	if invitations, err := srv.ListViewerRepositoryInvitations(as("U_2")); err != nil || len(invitations) != 0 {
+		t.Errorf("declined invitation remains: %+v (%v)", invitations, err)
+	}
+}
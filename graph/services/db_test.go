@@ -0,0 +1,21 @@
+package services_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/internal/testutil"
+)
+
+// newTestDB は、setup.shと同じスキーマを持つ空のDBを返す
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	return testutil.NewDB(t)
+}
+
+func mustExec(t *testing.T, db *sql.DB, query string, args ...interface{}) {
+	t.Helper()
+	if _, err := db.Exec(query, args...); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"github.com/saki-engineering/graphql-sample/graph/model"
+)
+
+type ChangeAction int
+
+const (
+	ChangeCreated ChangeAction = iota
+	ChangeUpdated
+)
+
+// IssueEvent は、Issueの作成・変更を表す
+// ChangedFieldsには値が変わったフィールドのGraphQL上の名前が入る
+type IssueEvent struct {
+	Action        ChangeAction
+	ChangedFields []string
+	Issue         *model.Issue
+}
+
+// PullRequestEvent は、PullRequestの作成・変更を表す
+type PullRequestEvent struct {
+	Action        ChangeAction
+	ChangedFields []string
+	PullRequest   *model.PullRequest
+}
+
+// boolToInt64 は、真偽値をDBの0/1に変換する
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
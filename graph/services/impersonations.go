@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/db"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type impersonationService struct {
+	exec boil.ContextExecutor
+	now  func() time.Time
+}
+
+// ImpersonateUser は、閲覧者の管理者がなりすます先のユーザーを返す
+// 管理者へのなりすましは、管理者の権限を借りることになるので許可しない
+func (i *impersonationService) ImpersonateUser(ctx context.Context, name string) (*model.User, error) {
+	if err := policy.Authorize(policy.UserImpersonate, facts(ctx, "", "")); err != nil {
+		return nil, err
+	}
+	target, err := db.Users(db.UserWhere.Name.EQ(name)).One(ctx, i.exec)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, apperrors.New(apperrors.NotFound, "user %q not found", name)
+		}
+		return nil, err
+	}
+	if target.IsSiteAdmin {
+		return nil, apperrors.New(apperrors.Forbidden, "site admins cannot be impersonated")
+	}
+	return convertUser(target), nil
+}
+
+// RecordImpersonation は、なりすましで実行されたオペレーションを記録する
+func (i *impersonationService) RecordImpersonation(ctx context.Context, adminID, targetID, operationName string) error {
+	record := &db.Impersonation{
+		ID:            uuid.New().String(),
+		Admin:         adminID,
+		Target:        targetID,
+		OperationName: operationName,
+		CreatedAt:     i.now(),
+	}
+	return record.Insert(ctx, i.exec, boil.Infer())
+}
@@ -0,0 +1,62 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+)
+
+func TestImpersonateUser(t *testing.T) {
+	db := newTestDB(t)
+	mustExec(t, db, `INSERT INTO users(id, name, is_site_admin) VALUES ('U_1', 'hsaki', TRUE), ('U_2', 'octocat', FALSE), ('U_3', 'staff', TRUE)`)
+	srv := services.New(db)
+
+	admin, err := srv.GetUserByID(context.Background(), "U_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !admin.IsSiteAdmin {
+		t.Fatal("U_1 should be a site admin")
+	}
+	ctx := auth.WithUser(context.Background(), admin, scope.All)
+
+	target, err := srv.ImpersonateUser(ctx, "octocat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.ID != "U_2" {
+		t.Errorf("impersonated %s, want U_2", target.ID)
+	}
+
+	if _, err := srv.ImpersonateUser(ctx, "nobody"); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+	if _, err := srv.ImpersonateUser(ctx, "staff"); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("impersonating another admin: want forbidden, but got %v", err)
+	}
+	if _, err := srv.ImpersonateUser(as("U_2"), "hsaki"); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("non-admin: want forbidden, but got %v", err)
+	}
+}
+
+func TestRecordImpersonation(t *testing.T) {
+	db := newTestDB(t)
+	mustExec(t, db, `INSERT INTO users(id, name, is_site_admin) VALUES ('U_1', 'hsaki', TRUE), ('U_2', 'octocat', FALSE)`)
+	srv := services.New(db)
+
+	if err := srv.RecordImpersonation(context.Background(), "U_1", "U_2", "GetViewer"); err != nil {
+		t.Fatal(err)
+	}
+
+	var admin, target, operationName string
+	if err := db.QueryRow(`SELECT admin, target, operation_name FROM impersonations`).Scan(&admin, &target, &operationName); err != nil {
+		t.Fatal(err)
+	}
+	if admin != "U_1" || target != "U_2" || operationName != "GetViewer" {
+		t.Errorf("unexpected record: %s, %s, %s", admin, target, operationName)
+	}
+}
@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/db"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type interactionService struct {
+	exec boil.ContextExecutor
+	now  func() time.Time
+}
+
+// interactionLimitExpiries は、やり取りの制限が続く期間
+var interactionLimitExpiries = map[model.RepositoryInteractionLimitExpiry]time.Duration{
+	model.RepositoryInteractionLimitExpiryOneDay:    24 * time.Hour,
+	model.RepositoryInteractionLimitExpiryThreeDays: 3 * 24 * time.Hour,
+	model.RepositoryInteractionLimitExpiryOneWeek:   7 * 24 * time.Hour,
+	model.RepositoryInteractionLimitExpiryOneMonth:  30 * 24 * time.Hour,
+	model.RepositoryInteractionLimitExpirySixMonths: 180 * 24 * time.Hour,
+}
+
+// ownedRepository は、columnのリポジトリをownerIDのユーザーが持っているものだけに絞り込む
+func ownedRepository(column, ownerID string) qm.QueryMod {
+	return qm.Where(column+" IN (SELECT id FROM repositories WHERE owner = ?)", ownerID)
+}
+
+// isBlocked は、blockerのユーザーがblockedのユーザーをブロックしているかを返す
+func isBlocked(ctx context.Context, exec boil.ContextExecutor, blocker, blocked string) (bool, error) {
+	return db.UserBlockExists(ctx, exec, blocker, blocked)
+}
+
+// checkInteraction は、ctxのユーザーがrepoIDのリポジトリでIssueの作成などのやり取りをできるかを確かめる
+// リポジトリの持ち主にブロックされている場合と、やり取りの制限の対象になっている場合は拒否する
+// 持ち主とcollaboratorには、やり取りの制限はかからない
+func checkInteraction(ctx context.Context, exec boil.ContextExecutor, repoID string, now time.Time) error {
+	viewer, ok := auth.GetUser(ctx)
+	if !ok {
+		return apperrors.New(apperrors.Forbidden, "not authenticated")
+	}
+	repo, err := db.FindRepository(ctx, exec, repoID, db.RepositoryColumns.ID, db.RepositoryColumns.Owner)
+	if err != nil {
+		return err
+	}
+	if repo.Owner == viewer.ID {
+		return nil
+	}
+
+	if blocked, err := isBlocked(ctx, exec, repo.Owner, viewer.ID); err != nil {
+		return err
+	} else if blocked {
+		return apperrors.New(apperrors.Forbidden, "you have been blocked by the owner of this repository")
+	}
+
+	limit, err := activeInteractionLimit(ctx, exec, repoID, now)
+	if err != nil || limit == nil {
+		return err
+	}
+	if collaborator, err := db.CollaboratorExists(ctx, exec, repoID, viewer.ID); err != nil {
+		return err
+	} else if collaborator {
+		return nil
+	}
+
+	switch model.RepositoryInteractionLimit(limit.InteractionLimit) {
+	case model.RepositoryInteractionLimitContributorsOnly:
+		contributed, err := db.Issues(
+			db.IssueWhere.Repository.EQ(repoID),
+			db.IssueWhere.Author.EQ(viewer.ID),
+		).Exists(ctx, exec)
+		if err != nil || contributed {
+			return err
+		}
+		return apperrors.New(apperrors.Forbidden, "interactions in this repository are limited to prior contributors until %s",
+			limit.ExpiresAt.Format(time.RFC3339))
+	default:
+		return apperrors.New(apperrors.Forbidden, "interactions in this repository are limited to collaborators until %s",
+			limit.ExpiresAt.Format(time.RFC3339))
+	}
+}
+
+// activeInteractionLimit は、期限が切れていないやり取りの制限を返す。制限がない場合はnilを返す
+func activeInteractionLimit(ctx context.Context, exec boil.ContextExecutor, repoID string, now time.Time) (*db.RepositoryInteractionLimit, error) {
+	limit, err := db.RepositoryInteractionLimits(
+		db.RepositoryInteractionLimitWhere.Repository.EQ(repoID),
+		db.RepositoryInteractionLimitWhere.ExpiresAt.GT(now.UTC()),
+	).One(ctx, exec)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return limit, nil
+}
+
+// BlockUser は、ログインユーザーがuserIDのユーザーをブロックする
+// ブロックしたユーザーは、ログインユーザーのリポジトリのcollaboratorから外し、届いている招待も取り消す
+func (s *interactionService) BlockUser(ctx context.Context, userID string) (*model.User, error) {
+	if err := policy.Authorize(policy.UserBlock, facts(ctx, "", "")); err != nil {
+		return nil, err
+	}
+	viewer, _ := auth.GetUser(ctx)
+	if userID == viewer.ID {
+		return nil, apperrors.New(apperrors.Validation, "you cannot block yourself")
+	}
+	user, err := db.FindUser(ctx, s.exec, userID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, apperrors.New(apperrors.NotFound, "user not found")
+		}
+		return nil, err
+	}
+
+	// ブロックとcollaboratorの削除は、途中で失敗しても中途半端に残らないようまとめて行う
+	exec, commit, rollback, err := beginTx(ctx, s.exec)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	block := &db.UserBlock{
+		Blocker:   viewer.ID,
+		Blocked:   userID,
+		CreatedAt: s.now().UTC(),
+	}
+	// 既にブロックしている場合は何もしない
+	if err := block.Upsert(ctx, exec, false,
+		[]string{db.UserBlockColumns.Blocker, db.UserBlockColumns.Blocked},
+		boil.None(),
+		boil.Infer(),
+	); err != nil {
+		return nil, err
+	}
+	if _, err := db.Collaborators(
+		db.CollaboratorWhere.Collaborator.EQ(userID),
+		ownedRepository(db.CollaboratorColumns.Repository, viewer.ID),
+	).DeleteAll(ctx, exec); err != nil {
+		return nil, err
+	}
+	if _, err := db.RepositoryInvitations(
+		db.RepositoryInvitationWhere.Invitee.EQ(userID),
+		ownedRepository(db.RepositoryInvitationColumns.Repository, viewer.ID),
+	).DeleteAll(ctx, exec); err != nil {
+		return nil, err
+	}
+	if err := commit(); err != nil {
+		return nil, err
+	}
+	return convertUser(user), nil
+}
+
+// UnblockUser は、ブロックを解除する。外したcollaboratorは元に戻さない
+func (s *interactionService) UnblockUser(ctx context.Context, userID string) (*model.User, error) {
+	if err := policy.Authorize(policy.UserBlock, facts(ctx, "", "")); err != nil {
+		return nil, err
+	}
+	viewer, _ := auth.GetUser(ctx)
+	user, err := db.FindUser(ctx, s.exec, userID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, apperrors.New(apperrors.NotFound, "user not found")
+		}
+		return nil, err
+	}
+	if _, err := db.UserBlocks(
+		db.UserBlockWhere.Blocker.EQ(viewer.ID),
+		db.UserBlockWhere.Blocked.EQ(userID),
+	).DeleteAll(ctx, s.exec); err != nil {
+		return nil, err
+	}
+	return convertUser(user), nil
+}
+
+// IsBlockedByViewer は、ログインユーザーがuserIDのユーザーをブロックしているかを返す
+func (s *interactionService) IsBlockedByViewer(ctx context.Context, userID string) (bool, error) {
+	viewer, ok := auth.GetUser(ctx)
+	if !ok {
+		return false, nil
+	}
+	return isBlocked(ctx, s.exec, viewer.ID, userID)
+}
+
+// GetRepositoryInteractionAbility は、repoIDのリポジトリに今かかっているやり取りの制限を返す
+func (s *interactionService) GetRepositoryInteractionAbility(ctx context.Context, repoID string) (*model.RepositoryInteractionAbility, error) {
+	limit, err := activeInteractionLimit(ctx, s.exec, repoID, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if limit == nil {
+		return &model.RepositoryInteractionAbility{Limit: model.RepositoryInteractionLimitNoLimit}, nil
+	}
+	return &model.RepositoryInteractionAbility{
+		Limit:     model.RepositoryInteractionLimit(limit.InteractionLimit),
+		ExpiresAt: &limit.ExpiresAt,
+	}, nil
+}
+
+// SetRepositoryInteractionLimit は、repoIDのリポジトリでやり取りできるユーザーをexpiryの間だけ制限する
+// NO_LIMITを指定した場合は制限を解除する
+func (s *interactionService) SetRepositoryInteractionLimit(ctx context.Context, repoID string, limit model.RepositoryInteractionLimit, expiry model.RepositoryInteractionLimitExpiry) (*model.Repository, error) {
+	if !limit.IsValid() {
+		return nil, apperrors.New(apperrors.Validation, "invalid interaction limit %q", limit)
+	}
+	duration, ok := interactionLimitExpiries[expiry]
+	if !ok {
+		return nil, apperrors.New(apperrors.Validation, "invalid interaction limit expiry %q", expiry)
+	}
+	if err := authorize(ctx, s.exec, policy.RepositoryLimitInteractions, repoID, ""); err != nil {
+		return nil, err
+	}
+
+	if limit == model.RepositoryInteractionLimitNoLimit {
+		if _, err := db.RepositoryInteractionLimits(
+			db.RepositoryInteractionLimitWhere.Repository.EQ(repoID),
+		).DeleteAll(ctx, s.exec); err != nil {
+			return nil, err
+		}
+	} else {
+		row := &db.RepositoryInteractionLimit{
+			Repository:       repoID,
+			InteractionLimit: limit.String(),
+			ExpiresAt:        s.now().UTC().Add(duration),
+		}
+		if err := row.Upsert(ctx, s.exec, true,
+			[]string{db.RepositoryInteractionLimitColumns.Repository},
+			boil.Whitelist(db.RepositoryInteractionLimitColumns.InteractionLimit, db.RepositoryInteractionLimitColumns.ExpiresAt),
+			boil.Infer(),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	repo, err := db.FindRepository(ctx, s.exec, repoID)
+	if err != nil {
+		return nil, err
+	}
+	return convertRepository(repo), nil
+}
@@ -0,0 +1,205 @@
+package services_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+)
+
+// newPublicRepoDB は、U_1の公開リポジトリREPO_1と、そのcollaboratorのU_2を用意する
+func newPublicRepoDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db := newTestDB(t)
+	mustExec(t, db, `INSERT INTO users(id, name) VALUES ('U_1', 'hsaki'), ('U_2', 'octocat'), ('U_3', 'stranger'), ('U_4', 'contributor')`)
+	mustExec(t, db, `INSERT INTO repositories(id, owner, name) VALUES ('REPO_1', 'U_1', 'repo1')`)
+	mustExec(t, db, `INSERT INTO collaborators(repository, collaborator, permission) VALUES ('REPO_1', 'U_2', 'READ')`)
+	mustExec(t, db, `INSERT INTO issues(id, url, title, number, author, repository) VALUES ('ISSUE_1', '', 'first', 1, 'U_4', 'REPO_1')`)
+	return db
+}
+
+func TestBlockUser(t *testing.T) {
+	db := newPublicRepoDB(t)
+	mustExec(t, db, `INSERT INTO repository_invitations(id, repository, invitee, inviter, permission) VALUES ('INV_1', 'REPO_1', 'U_3', 'U_1', 'READ')`)
+	srv := services.New(db)
+
+	if _, err := srv.BlockUser(as("U_1"), "U_1"); apperrors.From(err).Code != apperrors.Validation {
+		t.Errorf("blocking yourself: want validation error, but got %v", err)
+	}
+	if _, err := srv.BlockUser(as("U_1"), "U_9"); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+
+	for _, id := range []string{"U_2", "U_3", "U_3"} {
+		if _, err := srv.BlockUser(as("U_1"), id); err != nil {
+			t.Fatalf("blocking %s: %v", id, err)
+		}
+	}
+	if blocked, err := srv.IsBlockedByViewer(as("U_1"), "U_2"); err != nil || !blocked {
+		t.Errorf("U_2 should be blocked: %v, %v", blocked, err)
+	}
+	if blocked, err := srv.IsBlockedByViewer(as("U_2"), "U_1"); err != nil || blocked {
+		t.Errorf("blocking is one-way: %v, %v", blocked, err)
+	}
+
+	// collaboratorから外れ、招待も取り消される
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM collaborators WHERE collaborator = 'U_2'`).Scan(&count); err != nil || count != 0 {
+		t.Errorf("collaborator should be removed: %d, %v", count, err)
+	}
+	if err := db.QueryRow(`SELECT COUNT(*) FROM repository_invitations`).Scan(&count); err != nil || count != 0 {
+		t.Errorf("invitation should be removed: %d, %v", count, err)
+	}
+
+	if _, err := srv.CreateIssue(as("U_2"), "REPO_1", "U_2", "hello"); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("blocked user: want forbidden, but got %v", err)
+	}
+	if _, err := srv.InviteCollaborator(as("U_1"), "REPO_1", "U_2", model.RepositoryPermissionRead); apperrors.From(err).Code != apperrors.Validation {
+		t.Errorf("inviting a blocked user: want validation error, but got %v", err)
+	}
+
+	if _, err := srv.UnblockUser(as("U_1"), "U_2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.CreateIssue(as("U_2"), "REPO_1", "U_2", "hello"); err != nil {
+		t.Errorf("unblocked user: %v", err)
+	}
+}
+
+func TestBlockUserIsAtomic(t *testing.T) {
+	db := newPublicRepoDB(t)
+	mustExec(t, db, `INSERT INTO repository_invitations(id, repository, invitee, inviter, permission) VALUES ('INV_1', 'REPO_1', 'U_2', 'U_1', 'READ')`)
+	// 招待の取り消しで失敗させる
+	mustExec(t, db, `CREATE TRIGGER fail_invitation_delete BEFORE DELETE ON repository_invitations BEGIN SELECT RAISE(ABORT, 'boom'); END`)
+	srv := services.New(db)
+
+	if _, err := srv.BlockUser(as("U_1"), "U_2"); err == nil {
+		t.Fatal("want error, but got nil")
+	}
+
+	// ブロックもcollaboratorの削除も残らない
+	var blocks, collaborators int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM user_blocks`).Scan(&blocks); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.QueryRow(`SELECT COUNT(*) FROM collaborators WHERE collaborator = 'U_2'`).Scan(&collaborators); err != nil {
+		t.Fatal(err)
+	}
+	if blocks != 0 || collaborators != 1 {
+		t.Errorf("partial block was left: blocks=%d collaborators=%d", blocks, collaborators)
+	}
+}
+
+func TestUpdateIssueInteraction(t *testing.T) {
+	srv := services.New(newPublicRepoDB(t))
+	title := "edited"
+
+	// ISSUE_1の作成者はU_4
+	if _, err := srv.BlockUser(as("U_1"), "U_4"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.UpdateIssue(as("U_4"), "ISSUE_1", &title, nil); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("blocked author: want forbidden, but got %v", err)
+	}
+	if _, err := srv.UpdateIssue(as("U_1"), "ISSUE_1", &title, nil); err != nil {
+		t.Errorf("owner: %v", err)
+	}
+
+	if _, err := srv.UnblockUser(as("U_1"), "U_4"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.SetRepositoryInteractionLimit(as("U_1"), "REPO_1", model.RepositoryInteractionLimitCollaboratorsOnly, model.RepositoryInteractionLimitExpiryOneDay); err != nil {
+		t.Fatal(err)
+	}
+	closed := true
+	if _, err := srv.UpdateIssue(as("U_4"), "ISSUE_1", nil, &closed); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("author outside the limit: want forbidden, but got %v", err)
+	}
+}
+
+func TestRepositoryInteractionLimit(t *testing.T) {
+	db := newPublicRepoDB(t)
+	srv := services.New(db)
+
+	if _, err := srv.SetRepositoryInteractionLimit(as("U_2"), "REPO_1", model.RepositoryInteractionLimitCollaboratorsOnly, model.RepositoryInteractionLimitExpiryOneDay); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("non-admin: want forbidden, but got %v", err)
+	}
+
+	if _, err := srv.SetRepositoryInteractionLimit(as("U_1"), "REPO_1", model.RepositoryInteractionLimitContributorsOnly, model.RepositoryInteractionLimitExpiryOneWeek); err != nil {
+		t.Fatal(err)
+	}
+	ability, err := srv.GetRepositoryInteractionAbility(as("U_3"), "REPO_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ability.Limit != model.RepositoryInteractionLimitContributorsOnly || ability.ExpiresAt == nil ||
+		ability.ExpiresAt.Sub(time.Now()) < 6*24*time.Hour {
+		t.Errorf("unexpected ability: %+v", ability)
+	}
+
+	tests := []struct {
+		user    string
+		limit   model.RepositoryInteractionLimit
+		allowed bool
+	}{
+		{user: "U_1", limit: model.RepositoryInteractionLimitContributorsOnly, allowed: true},
+		{user: "U_2", limit: model.RepositoryInteractionLimitContributorsOnly, allowed: true},
+		{user: "U_4", limit: model.RepositoryInteractionLimitContributorsOnly, allowed: true},
+		{user: "U_3", limit: model.RepositoryInteractionLimitContributorsOnly},
+		{user: "U_1", limit: model.RepositoryInteractionLimitCollaboratorsOnly, allowed: true},
+		{user: "U_2", limit: model.RepositoryInteractionLimitCollaboratorsOnly, allowed: true},
+		{user: "U_4", limit: model.RepositoryInteractionLimitCollaboratorsOnly},
+		{user: "U_3", limit: model.RepositoryInteractionLimitCollaboratorsOnly},
+		{user: "U_3", limit: model.RepositoryInteractionLimitNoLimit, allowed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.user+"/"+tt.limit.String(), func(t *testing.T) {
+			if _, err := srv.SetRepositoryInteractionLimit(as("U_1"), "REPO_1", tt.limit, model.RepositoryInteractionLimitExpiryOneDay); err != nil {
+				t.Fatal(err)
+			}
+			_, err := srv.CreateIssue(as(tt.user), "REPO_1", tt.user, "hello")
+			if tt.allowed && err != nil {
+				t.Errorf("want allowed, but got %v", err)
+			}
+			if !tt.allowed && apperrors.From(err).Code != apperrors.Forbidden {
+				t.Errorf("want forbidden, but got %v", err)
+			}
+		})
+	}
+
+	// 期限の切れた制限はかからない
+	mustExec(t, db, `INSERT INTO repository_interaction_limits(repository, interaction_limit, expires_at) VALUES ('REPO_1', 'COLLABORATORS_ONLY', '2000-01-01 00:00:00')`)
+	if _, err := srv.CreateIssue(as("U_3"), "REPO_1", "U_3", "expired"); err != nil {
+		t.Errorf("expired limit: %v", err)
+	}
+	ability, err = srv.GetRepositoryInteractionAbility(as("U_3"), "REPO_1")
+	if err != nil || ability.Limit != model.RepositoryInteractionLimitNoLimit || ability.ExpiresAt != nil {
+		t.Errorf("unexpected ability: %+v, %v", ability, err)
+	}
+}
+
+func TestRepositoryInteractionLimitExpires(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv := services.New(newPublicRepoDB(t), services.WithClock(func() time.Time { return now }))
+
+	if _, err := srv.SetRepositoryInteractionLimit(as("U_1"), "REPO_1", model.RepositoryInteractionLimitCollaboratorsOnly, model.RepositoryInteractionLimitExpiryOneDay); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(24*time.Hour - time.Second)
+	if _, err := srv.CreateIssue(as("U_3"), "REPO_1", "U_3", "hello"); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("before expiry: want forbidden, but got %v", err)
+	}
+
+	now = now.Add(time.Second)
+	if _, err := srv.CreateIssue(as("U_3"), "REPO_1", "U_3", "hello"); err != nil {
+		t.Errorf("after expiry: %v", err)
+	}
+	ability, err := srv.GetRepositoryInteractionAbility(as("U_3"), "REPO_1")
+	if err != nil || ability.Limit != model.RepositoryInteractionLimitNoLimit {
+		t.Errorf("unexpected ability: %+v, %v", ability, err)
+	}
+}
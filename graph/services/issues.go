@@ -4,16 +4,26 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
 	"github.com/saki-engineering/graphql-sample/graph/db"
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
 	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/graph/pubsub"
 
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type issueService struct {
-	exec boil.ContextExecutor
+	exec        boil.ContextExecutor
+	maxPageSize int
+	now         func() time.Time
+	events      *pubsub.Broker[*IssueEvent]
 }
 
 func convertIssue(issue *db.Issue) *model.Issue {
@@ -33,6 +43,14 @@ func convertIssue(issue *db.Issue) *model.Issue {
 	}
 }
 
+func convertIssueSlice(issues db.IssueSlice) []*model.Issue {
+	result := make([]*model.Issue, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, convertIssue(issue))
+	}
+	return result
+}
+
 func convertIssueConnection(issues db.IssueSlice, hasPrevPage, hasNextPage bool) *model.IssueConnection {
 	var result model.IssueConnection
 
@@ -55,16 +73,25 @@ func convertIssueConnection(issues db.IssueSlice, hasPrevPage, hasNextPage bool)
 	return &result
 }
 
+// getReadableIssue は、ctxのユーザーが読めるリポジトリのIssueだけを取得する
+func (i *issueService) getReadableIssue(ctx context.Context, id string) (*db.Issue, error) {
+	return db.Issues(
+		qm.Select(
+			db.IssueColumns.ID,
+			db.IssueColumns.URL,
+			db.IssueColumns.Title,
+			db.IssueColumns.Closed,
+			db.IssueColumns.Number,
+			db.IssueColumns.Author,
+			db.IssueColumns.Repository,
+		),
+		db.IssueWhere.ID.EQ(id),
+		readableRepository(ctx, db.IssueTableColumns.Repository),
+	).One(ctx, i.exec)
+}
+
 func (i *issueService) GetIssueByID(ctx context.Context, id string) (*model.Issue, error) {
-	issue, err := db.FindIssue(ctx, i.exec, id,
-		db.IssueColumns.ID,
-		db.IssueColumns.URL,
-		db.IssueColumns.Title,
-		db.IssueColumns.Closed,
-		db.IssueColumns.Number,
-		db.IssueColumns.Author,
-		db.IssueColumns.Repository,
-	)
+	issue, err := i.getReadableIssue(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +111,7 @@ func (i *issueService) GetIssueByRepoAndNumber(ctx context.Context, repoID strin
 		),
 		db.IssueWhere.Repository.EQ(repoID),
 		db.IssueWhere.Number.EQ(int64(number)),
+		readableRepository(ctx, db.IssueTableColumns.Repository),
 	).One(ctx, i.exec)
 	if err != nil {
 		return nil, err
@@ -91,8 +119,8 @@ func (i *issueService) GetIssueByRepoAndNumber(ctx context.Context, repoID strin
 	return convertIssue(issue), nil
 }
 
-func (i *issueService) ListIssueInRepository(ctx context.Context, repoID string, after *string, before *string, first *int, last *int) (*model.IssueConnection, error) {
-	cond := []qm.QueryMod{
+func (i *issueService) ListIssuesByID(ctx context.Context, IDs []string) ([]*model.Issue, error) {
+	issues, err := db.Issues(
 		qm.Select(
 			db.IssueColumns.ID,
 			db.IssueColumns.URL,
@@ -102,80 +130,155 @@ func (i *issueService) ListIssueInRepository(ctx context.Context, repoID string,
 			db.IssueColumns.Author,
 			db.IssueColumns.Repository,
 		),
-		db.IssueWhere.Repository.EQ(repoID),
-	}
-	var scanDesc bool
-
-	switch {
-	case (after != nil) && (before != nil):
-		cond = append(cond, db.IssueWhere.ID.GT(*after), db.IssueWhere.ID.LT(*before))
-	case after != nil:
-		cond = append(cond,
-			db.IssueWhere.ID.GT(*after),
-			qm.OrderBy(fmt.Sprintf("%s asc", db.IssueColumns.ID)),
-		)
-		if first != nil {
-			cond = append(cond, qm.Limit(*first))
-		}
-	case before != nil:
-		scanDesc = true
-		cond = append(cond,
-			db.IssueWhere.ID.LT(*before),
-			qm.OrderBy(fmt.Sprintf("%s desc", db.IssueColumns.ID)),
-		)
-		if last != nil {
-			cond = append(cond, qm.Limit(*last))
-		}
-	default:
-		switch {
-		case last != nil:
-			scanDesc = true
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s desc", db.IssueColumns.ID)),
-				qm.Limit(*last),
-			)
-		case first != nil:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.IssueColumns.ID)),
-				qm.Limit(*first),
-			)
-		default:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.IssueColumns.ID)),
-			)
-		}
+		db.IssueWhere.ID.IN(IDs),
+		readableRepository(ctx, db.IssueTableColumns.Repository),
+	).All(ctx, i.exec)
+	if err != nil {
+		return nil, err
 	}
+	return convertIssueSlice(issues), nil
+}
 
-	issues, err := db.Issues(cond...).All(ctx, i.exec)
+func (i *issueService) ListIssueInRepository(ctx context.Context, repoID string, after *string, before *string, first *int, last *int) (*model.IssueConnection, error) {
+	issues, err := paginate(ctx, connectionQuery[*db.Issue]{
+		maxPageSize:  i.maxPageSize,
+		cursorColumn: db.IssueColumns.ID,
+		columns: []string{
+			db.IssueColumns.ID,
+			db.IssueColumns.URL,
+			db.IssueColumns.Title,
+			db.IssueColumns.Closed,
+			db.IssueColumns.Number,
+			db.IssueColumns.Author,
+			db.IssueColumns.Repository,
+		},
+		filter: []qm.QueryMod{
+			db.IssueWhere.Repository.EQ(repoID),
+			readableRepository(ctx, db.IssueTableColumns.Repository),
+		},
+		all: func(ctx context.Context, mods ...qm.QueryMod) ([]*db.Issue, error) {
+			return db.Issues(mods...).All(ctx, i.exec)
+		},
+		exists: func(ctx context.Context, mods ...qm.QueryMod) (bool, error) {
+			return db.Issues(mods...).Exists(ctx, i.exec)
+		},
+	}, after, before, first, last)
 	if err != nil {
 		return nil, err
 	}
 
-	var hasNextPage, hasPrevPage bool
-	if len(issues) != 0 {
-		if scanDesc {
-			for i, j := 0, len(issues)-1; i < j; i, j = i+1, j-1 {
-				issues[i], issues[j] = issues[j], issues[i]
-			}
-		}
-		startCursor, endCursor := issues[0].ID, issues[len(issues)-1].ID
+	return convertIssueConnection(issues.rows, issues.hasPreviousPage, issues.hasNextPage), nil
+}
 
-		var err error
-		hasPrevPage, err = db.Issues(
-			db.IssueWhere.Repository.EQ(repoID),
-			db.IssueWhere.ID.LT(startCursor),
-		).Exists(ctx, i.exec)
-		if err != nil {
-			return nil, err
+func (i *issueService) CreateIssue(ctx context.Context, repoID, authorID, title string) (*model.Issue, error) {
+	if err := authorize(ctx, i.exec, policy.IssueCreate, repoID, ""); err != nil {
+		return nil, err
+	}
+	if err := checkInteraction(ctx, i.exec, repoID, i.now()); err != nil {
+		return nil, err
+	}
+	repo, err := db.FindRepository(ctx, i.exec, repoID, db.RepositoryColumns.ID, db.RepositoryColumns.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同時に作成されて番号が重なった場合は、UNIQUE制約で弾かれるので番号を取り直す
+	var issue *db.Issue
+	for attempt := 0; ; attempt++ {
+		issue, err = i.insertIssue(ctx, repo, authorID, title)
+		if err == nil {
+			break
 		}
-		hasNextPage, err = db.Issues(
-			db.IssueWhere.Repository.EQ(repoID),
-			db.IssueWhere.ID.GT(endCursor),
-		).Exists(ctx, i.exec)
-		if err != nil {
+		if apperrors.From(err).Code != apperrors.Conflict || attempt >= maxIssueNumberRetries {
 			return nil, err
 		}
 	}
 
-	return convertIssueConnection(issues, hasPrevPage, hasNextPage), nil
+	result := convertIssue(issue)
+	i.events.Publish(repoID, &IssueEvent{Action: ChangeCreated, ChangedFields: []string{}, Issue: result})
+	return result, nil
+}
+
+// maxIssueNumberRetries は、Issueの番号が重なった場合に取り直す回数
+const maxIssueNumberRetries = 3
+
+// insertIssue は、リポジトリごとの連番を振ってIssueを追加する
+// 番号の読み取りと追加は1つのトランザクションで行う
+func (i *issueService) insertIssue(ctx context.Context, repo *db.Repository, authorID, title string) (*db.Issue, error) {
+	exec, commit, rollback, err := beginTx(ctx, i.exec)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	var maxNumber null.Int64
+	err = db.Issues(
+		qm.Select(fmt.Sprintf("max(%s)", db.IssueColumns.Number)),
+		db.IssueWhere.Repository.EQ(repo.ID),
+	).QueryRowContext(ctx, exec).Scan(&maxNumber)
+	if err != nil {
+		return nil, err
+	}
+	number := maxNumber.Int64 + 1
+
+	issue := &db.Issue{
+		ID:         globalid.Encode(globalid.Issue, uuid.New().String()),
+		URL:        fmt.Sprintf("http://example.com/%s/issue/%d", repo.Name, number),
+		Title:      title,
+		Number:     number,
+		Author:     authorID,
+		Repository: repo.ID,
+	}
+	if err := issue.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+	if err := commit(); err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// UpdateIssue は、nilでない値だけを更新する
+// 実際に値が変わった場合のみ変更を通知する
+// 更新できるかはpolicy.IssueUpdateで決める
+func (i *issueService) UpdateIssue(ctx context.Context, id string, title *string, closed *bool) (*model.Issue, error) {
+	issue, err := i.getReadableIssue(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorize(ctx, i.exec, policy.IssueUpdate, issue.Repository, issue.Author); err != nil {
+		return nil, err
+	}
+	// 作成者でも、持ち主にブロックされた後ややり取りの制限中は更新できない
+	if err := checkInteraction(ctx, i.exec, issue.Repository, i.now()); err != nil {
+		return nil, err
+	}
+
+	var columns, fields []string
+	if title != nil && *title != issue.Title {
+		issue.Title = *title
+		columns = append(columns, db.IssueColumns.Title)
+		fields = append(fields, "title")
+	}
+	if closed != nil && *closed != (issue.Closed == 1) {
+		issue.Closed = boolToInt64(*closed)
+		columns = append(columns, db.IssueColumns.Closed)
+		fields = append(fields, "closed")
+	}
+	if len(columns) == 0 {
+		return convertIssue(issue), nil
+	}
+
+	if _, err := issue.Update(ctx, i.exec, boil.Whitelist(columns...)); err != nil {
+		return nil, err
+	}
+	result := convertIssue(issue)
+	i.events.Publish(issue.Repository, &IssueEvent{Action: ChangeUpdated, ChangedFields: fields, Issue: result})
+	return result, nil
+}
+
+// SubscribeIssueEvents は、repoIDのリポジトリのIssueの作成・変更を受け取るチャネルを返す
+// ctxが終了するとチャネルは閉じられる
+func (i *issueService) SubscribeIssueEvents(ctx context.Context, repoID string) <-chan *IssueEvent {
+	return i.events.Subscribe(ctx, repoID)
 }
@@ -0,0 +1,106 @@
+package services_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUpdateIssuePublishesChangedFields(t *testing.T) {
+	db := newTestDB(t)
+	mustExec(t, db, `INSERT INTO users(id, name) VALUES ('U_1', 'hsaki')`)
+	mustExec(t, db, `INSERT INTO repositories(id, owner, name) VALUES ('REPO_1', 'U_1', 'repo1')`)
+	mustExec(t, db, `INSERT INTO issues(id, url, title, closed, number, author, repository) VALUES ('ISSUE_1', 'http://example.com/repo1/issue/1', 'First Issue', 0, 1, 'U_1', 'REPO_1')`)
+	srv := services.New(db)
+
+	// 更新にはTRIAGE以上の権限が必要なので、リポジトリの持ち主として更新する
+	ctx, cancel := context.WithCancel(auth.WithUser(context.Background(), &model.User{ID: "U_1"}, scope.All))
+	t.Cleanup(cancel)
+	events := srv.SubscribeIssueEvents(ctx, "REPO_1")
+
+	// 値が変わらない更新は通知されない
+	title := "First Issue"
+	if _, err := srv.UpdateIssue(ctx, "ISSUE_1", &title, nil); err != nil {
+		t.Fatal(err)
+	}
+	title, closed := "Renamed", true
+	issue, err := srv.UpdateIssue(ctx, "ISSUE_1", &title, &closed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Title != title || !issue.Closed {
+		t.Errorf("issue is not updated: %+v", issue)
+	}
+
+	select {
+	case event := <-events:
+		if event.Action != services.ChangeUpdated {
+			t.Errorf("want ChangeUpdated, but got %v", event.Action)
+		}
+		if diff := cmp.Diff([]string{"title", "closed"}, event.ChangedFields); diff != "" {
+			t.Errorf("changed fields mismatch (-want +got):\n%s", diff)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("event is not published")
+	}
+	select {
+	case event := <-events:
+		t.Errorf("unexpected event: %+v", event)
+	default:
+	}
+}
+
+func TestCreateIssueNumbersAreUnique(t *testing.T) {
+	db := newTestDB(t)
+	mustExec(t, db, `INSERT INTO users(id, name) VALUES ('U_1', 'hsaki')`)
+	mustExec(t, db, `INSERT INTO repositories(id, owner, name) VALUES ('REPO_1', 'U_1', 'repo1')`)
+	mustExec(t, db, `INSERT INTO issues(id, url, title, number, author, repository) VALUES ('ISSUE_1', '', 'first', 1, 'U_1', 'REPO_1')`)
+	srv := services.New(db)
+
+	// 同時に作成しても番号は重ならない
+	const n = 10
+	numbers := make(chan int, n)
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			issue, err := srv.CreateIssue(as("U_1"), "REPO_1", "U_1", "concurrent")
+			if err != nil {
+				errs <- err
+				return
+			}
+			numbers <- issue.Number
+		}()
+	}
+	wg.Wait()
+	close(numbers)
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	var got []int
+	for number := range numbers {
+		got = append(got, number)
+	}
+	sort.Ints(got)
+	want := []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("issue numbers mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := db.Exec(`INSERT INTO issues(id, url, title, number, author, repository) VALUES ('ISSUE_DUP', '', 'dup', 1, 'U_1', 'REPO_1')`); err == nil {
+		t.Error("duplicate issue number should be rejected")
+	}
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// connectionQuery は、1つのconnectionをページングするのに必要な情報をまとめたもの
+// テーブルごとのsqlboilerのクエリ関数をall/existsに渡す
+type connectionQuery[T any] struct {
+	// first/lastに指定できる値の上限
+	maxPageSize int
+	// カーソルとして使うカラム(昇順に並べたものがconnectionの順序になる)
+	cursorColumn string
+	// SELECTするカラム
+	columns []string
+	// connectionに含まれる行を絞り込む条件
+	filter []qm.QueryMod
+
+	all    func(ctx context.Context, mods ...qm.QueryMod) ([]T, error)
+	exists func(ctx context.Context, mods ...qm.QueryMod) (bool, error)
+}
+
+type page[T any] struct {
+	rows            []T
+	hasPreviousPage bool
+	hasNextPage     bool
+}
+
+const (
+	codeMissingPaginationBoundaries = "MISSING_PAGINATION_BOUNDARIES"
+	codeExcessivePagination         = "EXCESSIVE_PAGINATION"
+)
+
+func paginationError(code, format string, args ...interface{}) *gqlerror.Error {
+	err := gqlerror.Errorf(format, args...)
+	err.Extensions = map[string]interface{}{
+		"code": code,
+	}
+	return err
+}
+
+// validatePageArgs は、テーブル全体を読み出すようなクエリを防ぐため
+// first/lastのどちらかが必ず指定され、かつmaxPageSize以下であることを確かめる
+func validatePageArgs(maxPageSize int, first *int, last *int) error {
+	if first == nil && last == nil {
+		return paginationError(codeMissingPaginationBoundaries,
+			"You must provide a `first` or `last` value to properly paginate the connection.")
+	}
+	if first != nil && *first < 0 {
+		return apperrors.New(apperrors.Validation, "first must be a non-negative integer")
+	}
+	if last != nil && *last < 0 {
+		return apperrors.New(apperrors.Validation, "last must be a non-negative integer")
+	}
+	if first != nil && *first > maxPageSize {
+		return paginationError(codeExcessivePagination,
+			"Requesting %d records on the connection exceeds the `first` limit of %d records.", *first, maxPageSize)
+	}
+	if last != nil && *last > maxPageSize {
+		return paginationError(codeExcessivePagination,
+			"Requesting %d records on the connection exceeds the `last` limit of %d records.", *last, maxPageSize)
+	}
+	return nil
+}
+
+// paginate は Relay の Cursor Connections Specification に従ってページングする
+// https://relay.dev/graphql/connections.htm#sec-Pagination-algorithm
+func paginate[T any](ctx context.Context, q connectionQuery[T], after *string, before *string, first *int, last *int) (*page[T], error) {
+	if err := validatePageArgs(q.maxPageSize, first, last); err != nil {
+		return nil, err
+	}
+
+	// ApplyCursorsToEdges
+	cursors := make([]qm.QueryMod, 0, 2)
+	if after != nil {
+		cursors = append(cursors, qm.Where(fmt.Sprintf("%s > ?", q.cursorColumn), *after))
+	}
+	if before != nil {
+		cursors = append(cursors, qm.Where(fmt.Sprintf("%s < ?", q.cursorColumn), *before))
+	}
+
+	mods := []qm.QueryMod{qm.Select(q.columns...)}
+	mods = append(mods, q.filter...)
+	mods = append(mods, cursors...)
+
+	// lastだけが指定された場合は末尾から読むので降順でスキャンする
+	scanDesc := first == nil && last != nil
+	if scanDesc {
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("%s desc", q.cursorColumn)))
+	} else {
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("%s asc", q.cursorColumn)))
+	}
+
+	// first/lastより1件多く読むことで、その先に要素があるかどうかを判定する
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	}
+	if last != nil && *last+1 > limit {
+		limit = *last + 1
+	}
+	mods = append(mods, qm.Limit(limit))
+
+	rows, err := q.all(ctx, mods...)
+	if err != nil {
+		return nil, err
+	}
+	if scanDesc {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+	// カーソル適用後の要素数(limitで打ち切られている場合は下限)
+	edgeCount := len(rows)
+
+	result := &page[T]{}
+	if first != nil && len(rows) > *first {
+		rows = rows[:*first]
+	}
+	if last != nil && len(rows) > *last {
+		rows = rows[len(rows)-*last:]
+	}
+	result.rows = rows
+
+	switch {
+	case last != nil:
+		result.hasPreviousPage = edgeCount > *last
+	case after != nil:
+		// afterで指定された要素自身も、このページより前にある要素とみなす
+		result.hasPreviousPage, err = q.exists(ctx,
+			append(q.filter[:len(q.filter):len(q.filter)], qm.Where(fmt.Sprintf("%s <= ?", q.cursorColumn), *after))...,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case first != nil:
+		result.hasNextPage = edgeCount > *first
+	case before != nil:
+		result.hasNextPage, err = q.exists(ctx,
+			append(q.filter[:len(q.filter):len(q.filter)], qm.Where(fmt.Sprintf("%s >= ?", q.cursorColumn), *before))...,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
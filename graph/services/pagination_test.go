@@ -0,0 +1,403 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// pageResult は、connectionの返すカーソル列とPageInfoを比較しやすい形にしたもの
+type pageResult struct {
+	Cursors         []string
+	HasPreviousPage bool
+	HasNextPage     bool
+}
+
+type pageArgs struct {
+	after, before *string
+	first, last   *int
+}
+
+func (a pageArgs) String() string {
+	show := func(v interface{}) string {
+		switch v := v.(type) {
+		case *string:
+			if v != nil {
+				return *v
+			}
+		case *int:
+			if v != nil {
+				return fmt.Sprint(*v)
+			}
+		}
+		return "nil"
+	}
+	return fmt.Sprintf("after=%s before=%s first=%s last=%s", show(a.after), show(a.before), show(a.first), show(a.last))
+}
+
+// relayPage は仕様の疑似コードをそのまま書き下したリファレンス実装
+// https://relay.dev/graphql/connections.htm#sec-Pagination-algorithm
+func relayPage(all []string, args pageArgs) pageResult {
+	var edges []string
+	for _, c := range all {
+		if args.after != nil && c <= *args.after {
+			continue
+		}
+		if args.before != nil && c >= *args.before {
+			continue
+		}
+		edges = append(edges, c)
+	}
+	count := len(edges)
+	if args.first != nil && len(edges) > *args.first {
+		edges = edges[:*args.first]
+	}
+	if args.last != nil && len(edges) > *args.last {
+		edges = edges[len(edges)-*args.last:]
+	}
+
+	var result pageResult
+	if len(edges) != 0 {
+		result.Cursors = edges
+	}
+	switch {
+	case args.last != nil:
+		result.HasPreviousPage = count > *args.last
+	case args.after != nil:
+		for _, c := range all {
+			if c <= *args.after {
+				result.HasPreviousPage = true
+			}
+		}
+	}
+	switch {
+	case args.first != nil:
+		result.HasNextPage = count > *args.first
+	case args.before != nil:
+		for _, c := range all {
+			if c >= *args.before {
+				result.HasNextPage = true
+			}
+		}
+	}
+	return result
+}
+
+func toPageResult(edges []string, pageInfo *model.PageInfo) pageResult {
+	return pageResult{
+		Cursors:         edges,
+		HasPreviousPage: pageInfo.HasPreviousPage,
+		HasNextPage:     pageInfo.HasNextPage,
+	}
+}
+
+// connectionCase は、1つのconnectionをテストするための定義
+type connectionCase struct {
+	name string
+	// ownerに属する行と、属さない行(ノイズ)を追加する
+	insert func(t *testing.T, db *sql.DB, id string, owned bool)
+	list   func(ctx context.Context, srv services.Services, args pageArgs) (pageResult, error)
+}
+
+const paginationOwner = "OWNER_1"
+
+// insertIssue は、プロジェクトのアイテムの内容にするIssueをノイズのリポジトリに追加する
+func insertIssue(t *testing.T, db *sql.DB, id string) {
+	t.Helper()
+	mustExec(t, db, `INSERT INTO issues(id, url, title, number, author, repository)
+		SELECT ?, '', '', COALESCE(MAX(number), 0) + 1, 'U_1', 'OWNER_2' FROM issues WHERE repository = 'OWNER_2'`, id)
+}
+
+// insertProject は、カードを入れるプロジェクトを追加する
+func insertProject(t *testing.T, db *sql.DB, id string) {
+	t.Helper()
+	mustExec(t, db, `INSERT INTO projects(id, title, url, number, owner) VALUES (?, '', '', 1, 'U_1')`, id)
+}
+
+func ownerOf(owned bool) string {
+	if owned {
+		return paginationOwner
+	}
+	return "OWNER_2"
+}
+
+var connectionCases = []connectionCase{
+	{
+		name: "Repository.issues",
+		insert: func(t *testing.T, db *sql.DB, id string, owned bool) {
+			mustExec(t, db, `INSERT INTO issues(id, url, title, number, author, repository)
+				SELECT ?, '', '', COALESCE(MAX(number), 0) + 1, 'U_1', ? FROM issues WHERE repository = ?`, id, ownerOf(owned), ownerOf(owned))
+		},
+		list: func(ctx context.Context, srv services.Services, args pageArgs) (pageResult, error) {
+			conn, err := srv.ListIssueInRepository(ctx, paginationOwner, args.after, args.before, args.first, args.last)
+			if err != nil {
+				return pageResult{}, err
+			}
+			var edges []string
+			for _, edge := range conn.Edges {
+				edges = append(edges, edge.Cursor)
+			}
+			return toPageResult(edges, conn.PageInfo), nil
+		},
+	},
+	{
+		name: "Repository.pullRequests",
+		insert: func(t *testing.T, db *sql.DB, id string, owned bool) {
+			mustExec(t, db, `INSERT INTO pullrequests(id, base_ref_name, head_ref_name, url, number, repository) VALUES (?, '', '', '', 1, ?)`, id, ownerOf(owned))
+		},
+		list: func(ctx context.Context, srv services.Services, args pageArgs) (pageResult, error) {
+			conn, err := srv.ListPullRequestInRepository(ctx, paginationOwner, args.after, args.before, args.first, args.last)
+			if err != nil {
+				return pageResult{}, err
+			}
+			var edges []string
+			for _, edge := range conn.Edges {
+				edges = append(edges, edge.Cursor)
+			}
+			return toPageResult(edges, conn.PageInfo), nil
+		},
+	},
+	{
+		name: "User.projectV2s",
+		insert: func(t *testing.T, db *sql.DB, id string, owned bool) {
+			mustExec(t, db, `INSERT INTO projects(id, title, url, number, owner) VALUES (?, '', '', 1, ?)`, id, ownerOf(owned))
+		},
+		list: func(ctx context.Context, srv services.Services, args pageArgs) (pageResult, error) {
+			conn, err := srv.ListProjectByOwner(ctx, paginationOwner, args.after, args.before, args.first, args.last)
+			if err != nil {
+				return pageResult{}, err
+			}
+			var edges []string
+			for _, edge := range conn.Edges {
+				edges = append(edges, edge.Cursor)
+			}
+			return toPageResult(edges, conn.PageInfo), nil
+		},
+	},
+	{
+		name: "ProjectV2.items",
+		insert: func(t *testing.T, db *sql.DB, id string, owned bool) {
+			// 同じ内容のアイテムは1つのプロジェクトに1つだけなので、内容はカードごとに作る
+			insertIssue(t, db, id)
+			mustExec(t, db, `INSERT INTO projectcards(id, project, issue) VALUES (?, ?, ?)`, id, ownerOf(owned), id)
+		},
+		list: func(ctx context.Context, srv services.Services, args pageArgs) (pageResult, error) {
+			conn, err := srv.ListProjectItemOwnedByProject(ctx, paginationOwner, args.after, args.before, args.first, args.last)
+			if err != nil {
+				return pageResult{}, err
+			}
+			var edges []string
+			for _, edge := range conn.Edges {
+				edges = append(edges, edge.Cursor)
+			}
+			return toPageResult(edges, conn.PageInfo), nil
+		},
+	},
+	{
+		name: "Issue.projectItems",
+		insert: func(t *testing.T, db *sql.DB, id string, owned bool) {
+			// 同じIssueは1つのプロジェクトに1つだけなので、プロジェクトはカードごとに作る
+			insertProject(t, db, id)
+			if owned {
+				mustExec(t, db, `INSERT INTO projectcards(id, project, issue) VALUES (?, ?, ?)`, id, id, paginationOwner)
+			} else {
+				// 同じIDを持つPRのカードはノイズになる
+				mustExec(t, db, `INSERT INTO projectcards(id, project, pullrequest) VALUES (?, ?, ?)`, id, id, paginationOwner)
+			}
+		},
+		list: func(ctx context.Context, srv services.Services, args pageArgs) (pageResult, error) {
+			conn, err := srv.ListProjectItemOwnedByIssue(ctx, paginationOwner, args.after, args.before, args.first, args.last)
+			if err != nil {
+				return pageResult{}, err
+			}
+			var edges []string
+			for _, edge := range conn.Edges {
+				edges = append(edges, edge.Cursor)
+			}
+			return toPageResult(edges, conn.PageInfo), nil
+		},
+	},
+	{
+		name: "PullRequest.projectItems",
+		insert: func(t *testing.T, db *sql.DB, id string, owned bool) {
+			insertProject(t, db, id)
+			if owned {
+				mustExec(t, db, `INSERT INTO projectcards(id, project, pullrequest) VALUES (?, ?, ?)`, id, id, paginationOwner)
+			} else {
+				mustExec(t, db, `INSERT INTO projectcards(id, project, issue) VALUES (?, ?, ?)`, id, id, paginationOwner)
+			}
+		},
+		list: func(ctx context.Context, srv services.Services, args pageArgs) (pageResult, error) {
+			conn, err := srv.ListProjectItemOwnedByPullRequest(ctx, paginationOwner, args.after, args.before, args.first, args.last)
+			if err != nil {
+				return pageResult{}, err
+			}
+			var edges []string
+			for _, edge := range conn.Edges {
+				edges = append(edges, edge.Cursor)
+			}
+			return toPageResult(edges, conn.PageInfo), nil
+		},
+	},
+}
+
+func newPaginationDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db := newTestDB(t)
+	// connectionの親になる行を、どのconnectionでも使えるようにIDを揃えて用意する
+	// 親にならない方(OWNER_2)はノイズの行の親として使う
+	mustExec(t, db, `INSERT INTO users(id, name) VALUES ('U_1', 'hsaki'), (?, 'owner1'), ('OWNER_2', 'owner2')`, paginationOwner)
+	// Repository.issuesなどのconnectionで使うリポジトリは公開しておく
+	mustExec(t, db, `INSERT INTO repositories(id, owner, name) VALUES (?, 'U_1', 'owned'), ('OWNER_2', 'U_1', 'other')`, paginationOwner)
+	mustExec(t, db, `INSERT INTO projects(id, title, url, number, owner) VALUES (?, '', '', 1, 'U_1'), ('OWNER_2', '', '', 2, 'U_1')`, paginationOwner)
+	// Issue.projectItemsなどの親は、Repository.issuesなどに数えられないようノイズのリポジトリに置く
+	mustExec(t, db, `INSERT INTO issues(id, url, title, number, author, repository) VALUES (?, '', '', 1, 'U_1', 'OWNER_2')`, paginationOwner)
+	mustExec(t, db, `INSERT INTO pullrequests(id, base_ref_name, head_ref_name, url, number, repository) VALUES (?, '', '', '', 1, 'OWNER_2')`, paginationOwner)
+	return db
+}
+
+// randomCursor は、既存のカーソル・存在しないカーソル・nilのいずれかを返す
+func randomCursor(r *rand.Rand, universe int) *string {
+	if r.Intn(3) == 0 {
+		return nil
+	}
+	c := fmt.Sprintf("ID_%02d", r.Intn(universe+2))
+	return &c
+}
+
+func randomCount(r *rand.Rand, universe int) *int {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+	n := r.Intn(universe + 2)
+	return &n
+}
+
+func TestConnectionPagination(t *testing.T) {
+	const (
+		universe = 20
+		datasets = 10
+		queries  = 60
+	)
+
+	for _, cc := range connectionCases {
+		cc := cc
+		t.Run(cc.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			ctx := context.Background()
+
+			for d := 0; d < datasets; d++ {
+				db := newPaginationDB(t)
+				srv := services.New(db)
+
+				// universe個のIDをconnectionに属するもの・属さないもの・存在しないものに振り分ける
+				var owned []string
+				for _, i := range r.Perm(universe) {
+					id := fmt.Sprintf("ID_%02d", i)
+					switch r.Intn(3) {
+					case 0:
+						cc.insert(t, db, id, true)
+						owned = append(owned, id)
+					case 1:
+						cc.insert(t, db, id, false)
+					}
+				}
+				sort.Strings(owned)
+
+				for q := 0; q < queries; q++ {
+					args := pageArgs{
+						after:  randomCursor(r, universe),
+						before: randomCursor(r, universe),
+						first:  randomCount(r, universe),
+						last:   randomCount(r, universe),
+					}
+
+					got, err := cc.list(ctx, srv, args)
+					if args.first == nil && args.last == nil {
+						assertErrorCode(t, err, "MISSING_PAGINATION_BOUNDARIES")
+						continue
+					}
+					if err != nil {
+						t.Fatalf("%s: %v", args, err)
+					}
+					want := relayPage(owned, args)
+					if diff := cmp.Diff(want, got); diff != "" {
+						t.Errorf("dataset %v, %s: mismatch (-want +got):\n%s", owned, args, diff)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestConnectionPaginationNegativeCount(t *testing.T) {
+	negative := -1
+
+	for _, cc := range connectionCases {
+		cc := cc
+		t.Run(cc.name, func(t *testing.T) {
+			srv := services.New(newPaginationDB(t))
+			ctx := context.Background()
+
+			if _, err := cc.list(ctx, srv, pageArgs{first: &negative}); err == nil {
+				t.Error("negative first should be rejected")
+			}
+			if _, err := cc.list(ctx, srv, pageArgs{last: &negative}); err == nil {
+				t.Error("negative last should be rejected")
+			}
+		})
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) {
+		t.Fatalf("want error with code %s, but got %v", code, err)
+	}
+	if got := gqlErr.Extensions["code"]; got != code {
+		t.Errorf("want error with code %s, but got %v", code, got)
+	}
+}
+
+func TestConnectionPaginationBoundaries(t *testing.T) {
+	const maxPageSize = 5
+	limit, excessive := maxPageSize, maxPageSize+1
+
+	for _, cc := range connectionCases {
+		cc := cc
+		t.Run(cc.name, func(t *testing.T) {
+			srv := services.New(newPaginationDB(t), services.WithMaxPageSize(maxPageSize))
+			ctx := context.Background()
+
+			_, err := cc.list(ctx, srv, pageArgs{})
+			assertErrorCode(t, err, "MISSING_PAGINATION_BOUNDARIES")
+
+			_, err = cc.list(ctx, srv, pageArgs{first: &excessive})
+			assertErrorCode(t, err, "EXCESSIVE_PAGINATION")
+			_, err = cc.list(ctx, srv, pageArgs{last: &excessive})
+			assertErrorCode(t, err, "EXCESSIVE_PAGINATION")
+			_, err = cc.list(ctx, srv, pageArgs{first: &limit, last: &excessive})
+			assertErrorCode(t, err, "EXCESSIVE_PAGINATION")
+
+			if _, err := cc.list(ctx, srv, pageArgs{first: &limit}); err != nil {
+				t.Errorf("first=%d should be accepted: %v", limit, err)
+			}
+			if _, err := cc.list(ctx, srv, pageArgs{last: &limit}); err != nil {
+				t.Errorf("last=%d should be accepted: %v", limit, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/db"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// repositoryViewer は、リポジトリを読むときの閲覧者を返す
+// トークンにrepo:readのスコープが無い場合は、ログインしていない場合と同じく公開リポジトリだけを読める
+func repositoryViewer(ctx context.Context) (*model.User, bool) {
+	viewer, ok := auth.GetUser(ctx)
+	if !ok {
+		return nil, false
+	}
+	if scopes, _ := auth.GetScopes(ctx); !scopes.Has(scope.RepoRead) {
+		return nil, false
+	}
+	return viewer, true
+}
+
+// readableRepository は、columnのリポジトリをctxのユーザーが読めるものだけに絞り込む
+// 公開リポジトリ、自分が持っているリポジトリ、collaboratorになっているリポジトリが読める
+func readableRepository(ctx context.Context, column string) qm.QueryMod {
+	viewer, ok := repositoryViewer(ctx)
+	if !ok {
+		return qm.Where(column + " IN (SELECT id FROM repositories WHERE visibility = 'PUBLIC')")
+	}
+	return qm.Where(column+` IN (
+		SELECT id FROM repositories WHERE visibility = 'PUBLIC' OR owner = ?
+		UNION
+		SELECT repository FROM collaborators WHERE collaborator = ?
+	)`, viewer.ID, viewer.ID)
+}
+
+// viewerPermission は、ctxのユーザーがrepoIDのリポジトリに持つ権限を返す
+// 読めないリポジトリの場合は空文字を返す
+func viewerPermission(ctx context.Context, exec boil.ContextExecutor, repoID string) (model.RepositoryPermission, error) {
+	repo, err := db.FindRepository(ctx, exec, repoID,
+		db.RepositoryColumns.ID, db.RepositoryColumns.Owner, db.RepositoryColumns.Visibility,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	var permission model.RepositoryPermission
+	if repo.Visibility == model.RepositoryVisibilityPublic.String() {
+		permission = model.RepositoryPermissionRead
+	}
+	viewer, ok := repositoryViewer(ctx)
+	if !ok {
+		return permission, nil
+	}
+	if repo.Owner == viewer.ID {
+		return model.RepositoryPermissionAdmin, nil
+	}
+
+	collaborator, err := db.FindCollaborator(ctx, exec, repoID, viewer.ID, db.CollaboratorColumns.Permission)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return permission, nil
+		}
+		return "", err
+	}
+	if p := model.RepositoryPermission(collaborator.Permission); !policy.Includes(permission, p) {
+		permission = p
+	}
+	return permission, nil
+}
+
+// authorize は、repoIDのリポジトリに属する対象について、ctxのユーザーがactionを行えることを確かめる
+// ownerIDは対象の持ち主のID。読めないリポジトリは、存在を知られないようNotFoundにする
+func authorize(ctx context.Context, exec boil.ContextExecutor, action policy.Action, repoID, ownerID string) error {
+	permission, err := viewerPermission(ctx, exec, repoID)
+	if err != nil {
+		return err
+	}
+	if permission == "" {
+		return apperrors.New(apperrors.NotFound, "repository not found")
+	}
+	return policy.Authorize(action, facts(ctx, permission, ownerID))
+}
+
+// facts は、ctxのユーザーをルールの判定に使える形にする
+func facts(ctx context.Context, permission model.RepositoryPermission, ownerID string) policy.Facts {
+	viewer, _ := auth.GetUser(ctx)
+	return policy.Facts{Viewer: viewer, Permission: permission, OwnerID: ownerID}
+}
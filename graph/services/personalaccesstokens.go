@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/db"
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// personalAccessTokenPrefix は、発行するトークンの先頭に付ける文字列
+// ログや設定ファイルに紛れ込んだトークンを見分けやすくするため
+const personalAccessTokenPrefix = "pat_"
+
+type personalAccessTokenService struct {
+	exec boil.ContextExecutor
+	now  func() time.Time
+}
+
+func convertPersonalAccessToken(token *db.PersonalAccessToken) *model.PersonalAccessToken {
+	return &model.PersonalAccessToken{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scopes:     scope.FromString(token.Scopes).Strings(),
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt.Ptr(),
+		LastUsedAt: token.LastUsedAt.Ptr(),
+		RevokedAt:  token.RevokedAt.Ptr(),
+	}
+}
+
+// hashPersonalAccessToken は、トークンをDBに保存する形に変換する
+// トークンは十分な長さの乱数なので、ソルトなしのSHA-256で総当たりに耐えられる
+func hashPersonalAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func generatePersonalAccessToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return personalAccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// getOwnedPersonalAccessToken は、ownerIDのユーザーが発行したトークンだけを取得する
+// 他人のトークンは存在しないものとして扱う
+func (p *personalAccessTokenService) getOwnedPersonalAccessToken(ctx context.Context, ownerID, id string) (*db.PersonalAccessToken, error) {
+	return db.PersonalAccessTokens(
+		db.PersonalAccessTokenWhere.ID.EQ(id),
+		db.PersonalAccessTokenWhere.Owner.EQ(ownerID),
+	).One(ctx, p.exec)
+}
+
+func (p *personalAccessTokenService) GetPersonalAccessToken(ctx context.Context, ownerID, id string) (*model.PersonalAccessToken, error) {
+	token, err := p.getOwnedPersonalAccessToken(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertPersonalAccessToken(token), nil
+}
+
+// CreatePersonalAccessToken は、トークンを発行し、その情報と平文のトークンを返す
+// 平文のトークンは保存しないので、呼び出し元が利用者に渡す必要がある
+func (p *personalAccessTokenService) CreatePersonalAccessToken(ctx context.Context, ownerID, name string, scopes scope.Set, expiresAt *time.Time) (*model.PersonalAccessToken, string, error) {
+	if err := policy.Authorize(policy.PersonalAccessTokenManage, facts(ctx, "", ownerID)); err != nil {
+		return nil, "", err
+	}
+	if expiresAt != nil && !expiresAt.After(p.now()) {
+		return nil, "", apperrors.New(apperrors.Validation, "expiresAt must be in the future")
+	}
+
+	secret, err := generatePersonalAccessToken()
+	if err != nil {
+		return nil, "", err
+	}
+	token := &db.PersonalAccessToken{
+		ID:        globalid.Encode(globalid.PersonalAccessToken, uuid.New().String()),
+		Owner:     ownerID,
+		Name:      name,
+		TokenHash: hashPersonalAccessToken(secret),
+		Scopes:    scopes.String(),
+		CreatedAt: p.now(),
+		ExpiresAt: null.TimeFromPtr(expiresAt),
+	}
+	if err := token.Insert(ctx, p.exec, boil.Infer()); err != nil {
+		return nil, "", err
+	}
+	return convertPersonalAccessToken(token), secret, nil
+}
+
+// RevokePersonalAccessToken は、トークンを失効させる
+// 失効済みのトークンに対しては何もせず、そのまま返す
+func (p *personalAccessTokenService) RevokePersonalAccessToken(ctx context.Context, ownerID, id string) (*model.PersonalAccessToken, error) {
+	token, err := p.getOwnedPersonalAccessToken(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.Authorize(policy.PersonalAccessTokenManage, facts(ctx, "", token.Owner)); err != nil {
+		return nil, err
+	}
+	if !token.RevokedAt.Valid {
+		token.RevokedAt = null.TimeFrom(p.now())
+		if _, err := token.Update(ctx, p.exec, boil.Whitelist(db.PersonalAccessTokenColumns.RevokedAt)); err != nil {
+			return nil, err
+		}
+	}
+	return convertPersonalAccessToken(token), nil
+}
+
+// AuthenticateToken は、トークンの持ち主とトークンに与えられたスコープを返し、最終使用日時を更新する
+// 存在しない・期限切れ・失効済みのトークンは区別せずに拒否する
+func (p *personalAccessTokenService) AuthenticateToken(ctx context.Context, secret string) (*model.User, scope.Set, error) {
+	token, err := db.PersonalAccessTokens(
+		qm.Load(db.PersonalAccessTokenRels.OwnerUser),
+		db.PersonalAccessTokenWhere.TokenHash.EQ(hashPersonalAccessToken(secret)),
+	).One(ctx, p.exec)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, nil, apperrors.New(apperrors.Unauthenticated, "invalid token")
+		}
+		return nil, nil, err
+	}
+
+	now := p.now()
+	if token.RevokedAt.Valid || (token.ExpiresAt.Valid && !token.ExpiresAt.Time.After(now)) {
+		return nil, nil, apperrors.New(apperrors.Unauthenticated, "invalid token")
+	}
+
+	token.LastUsedAt = null.TimeFrom(now)
+	if _, err := token.Update(ctx, p.exec, boil.Whitelist(db.PersonalAccessTokenColumns.LastUsedAt)); err != nil {
+		// 最終使用日時の更新に失敗しても、認証は通す
+		log.Println("failed to update last used time of token:", err)
+	}
+	return convertUser(token.R.OwnerUser), scope.FromString(token.Scopes), nil
+}
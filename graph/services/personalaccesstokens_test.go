@@ -0,0 +1,109 @@
+package services_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPersonalAccessTokenLifecycle(t *testing.T) {
+	db := newTestDB(t)
+	mustExec(t, db, `INSERT INTO users(id, name) VALUES ('U_1', 'hsaki'), ('U_2', 'other')`)
+	srv := services.New(db)
+	ctx := as("U_1")
+
+	token, secret, err := srv.CreatePersonalAccessToken(ctx, "U_1", "ci", scope.Set{scope.RepoRead}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(secret, "pat_") {
+		t.Errorf("unexpected token format: %s", secret)
+	}
+
+	// 平文のトークンは保存しない
+	var stored int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM personal_access_tokens WHERE token_hash = ?`, secret).Scan(&stored); err != nil {
+		t.Fatal(err)
+	}
+	if stored != 0 {
+		t.Error("token is stored in plain text")
+	}
+
+	user, scopes, err := srv.AuthenticateToken(ctx, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != "U_1" || user.Name != "hsaki" {
+		t.Errorf("unexpected user: %+v", user)
+	}
+	if diff := cmp.Diff(scope.Set{scope.RepoRead}, scopes); diff != "" {
+		t.Errorf("scopes mismatch (-want +got):\n%s", diff)
+	}
+	got, err := srv.GetPersonalAccessToken(ctx, "U_1", token.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.LastUsedAt == nil {
+		t.Error("lastUsedAt is not updated")
+	}
+
+	// 他人のトークンは見えず、失効もできない
+	if _, err := srv.RevokePersonalAccessToken(ctx, "U_2", token.ID); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+
+	revoked, err := srv.RevokePersonalAccessToken(ctx, "U_1", token.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked.RevokedAt == nil {
+		t.Error("revokedAt is not set")
+	}
+	if _, _, err := srv.AuthenticateToken(ctx, secret); apperrors.From(err).Code != apperrors.Unauthenticated {
+		t.Errorf("want unauthenticated, but got %v", err)
+	}
+}
+
+func TestAuthenticateTokenRejects(t *testing.T) {
+	db := newTestDB(t)
+	mustExec(t, db, `INSERT INTO users(id, name) VALUES ('U_1', 'hsaki')`)
+	srv := services.New(db)
+	ctx := as("U_1")
+
+	expiresAt := time.Now().Add(time.Hour)
+	token, secret, err := srv.CreatePersonalAccessToken(ctx, "U_1", "short-lived", nil, &expiresAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := srv.AuthenticateToken(ctx, secret); err != nil {
+		t.Fatalf("token before expiry is rejected: %v", err)
+	}
+	mustExec(t, db, `UPDATE personal_access_tokens SET expires_at = ? WHERE id = ?`, time.Now().Add(-time.Minute), token.ID)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "expired", token: secret},
+		{name: "unknown", token: "pat_unknown"},
+		{name: "old user name scheme", token: "UT_hsaki"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := srv.AuthenticateToken(ctx, tt.token); apperrors.From(err).Code != apperrors.Unauthenticated {
+				t.Errorf("want unauthenticated, but got %v", err)
+			}
+		})
+	}
+
+	past := time.Now().Add(-time.Hour)
+	if _, _, err := srv.CreatePersonalAccessToken(ctx, "U_1", "expired", nil, &past); apperrors.From(err).Code != apperrors.Validation {
+		t.Errorf("want validation error, but got %v", err)
+	}
+}
@@ -2,10 +2,13 @@ package services
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
 	"github.com/saki-engineering/graphql-sample/graph/db"
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
 	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/graph/pubsub"
 
 	"github.com/google/uuid"
 	"github.com/volatiletech/null/v8"
@@ -14,13 +17,31 @@ import (
 )
 
 type projectItemService struct {
-	exec boil.ContextExecutor
+	exec        boil.ContextExecutor
+	maxPageSize int
+	events      *pubsub.Broker[*ProjectItemEvent]
+}
+
+type ProjectItemEventType int
+
+const (
+	ProjectItemAdded ProjectItemEventType = iota
+	ProjectItemRemoved
+	ProjectItemUpdated
+)
+
+// ProjectItemEvent は、プロジェクトのアイテムに対する変更を表す
+// Removedの場合、Itemにはすでに削除された後のアイテムが入る
+type ProjectItemEvent struct {
+	Type ProjectItemEventType
+	Item *model.ProjectV2Item
 }
 
 func convertProjectV2Item(item *db.Projectcard) *model.ProjectV2Item {
 	result := &model.ProjectV2Item{
-		ID:      item.ID,
-		Project: &model.ProjectV2{ID: item.Project},
+		ID:         item.ID,
+		Project:    &model.ProjectV2{ID: item.Project},
+		IsArchived: (item.Archived == 1),
 	}
 	if item.Issue.Valid {
 		result.Content = &model.Issue{ID: item.Issue.String}
@@ -31,6 +52,14 @@ func convertProjectV2Item(item *db.Projectcard) *model.ProjectV2Item {
 	return result
 }
 
+func convertProjectV2ItemSlice(items db.ProjectcardSlice) []*model.ProjectV2Item {
+	result := make([]*model.ProjectV2Item, 0, len(items))
+	for _, item := range items {
+		result = append(result, convertProjectV2Item(item))
+	}
+	return result
+}
+
 func convertProjectV2ItemConnection(items db.ProjectcardSlice, hasPrevPage, hasNextPage bool) *model.ProjectV2ItemConnection {
 	var result model.ProjectV2ItemConnection
 
@@ -59,6 +88,7 @@ func (p *projectItemService) GetProjectItemByID(ctx context.Context, id string)
 		db.ProjectcardColumns.Project,
 		db.ProjectcardColumns.Issue,
 		db.ProjectcardColumns.Pullrequest,
+		db.ProjectcardColumns.Archived,
 	)
 	if err != nil {
 		return nil, err
@@ -66,286 +96,206 @@ func (p *projectItemService) GetProjectItemByID(ctx context.Context, id string)
 	return convertProjectV2Item(item), nil
 }
 
-func (p *projectItemService) ListProjectItemOwnedByProject(ctx context.Context, projectID string, after *string, before *string, first *int, last *int) (*model.ProjectV2ItemConnection, error) {
-	cond := []qm.QueryMod{
+func (p *projectItemService) ListProjectItemsByID(ctx context.Context, IDs []string) ([]*model.ProjectV2Item, error) {
+	items, err := db.Projectcards(
 		qm.Select(
 			db.ProjectcardColumns.ID,
 			db.ProjectcardColumns.Project,
 			db.ProjectcardColumns.Issue,
 			db.ProjectcardColumns.Pullrequest,
+			db.ProjectcardColumns.Archived,
 		),
-		db.ProjectcardWhere.Project.EQ(projectID),
-	}
-	var scanDesc bool
-
-	switch {
-	case (after != nil) && (before != nil):
-		cond = append(cond, db.ProjectcardWhere.ID.GT(*after), db.ProjectcardWhere.ID.LT(*before))
-	case after != nil:
-		cond = append(cond,
-			db.ProjectcardWhere.ID.GT(*after),
-			qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectcardColumns.ID)),
-		)
-		if first != nil {
-			cond = append(cond, qm.Limit(*first))
-		}
-	case before != nil:
-		scanDesc = true
-		cond = append(cond,
-			db.ProjectcardWhere.ID.LT(*before),
-			qm.OrderBy(fmt.Sprintf("%s desc", db.ProjectcardColumns.ID)),
-		)
-		if last != nil {
-			cond = append(cond, qm.Limit(*last))
-		}
-	default:
-		switch {
-		case last != nil:
-			scanDesc = true
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s desc", db.ProjectcardColumns.ID)),
-				qm.Limit(*last),
-			)
-		case first != nil:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectcardColumns.ID)),
-				qm.Limit(*first),
-			)
-		default:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectcardColumns.ID)),
-			)
-		}
+		db.ProjectcardWhere.ID.IN(IDs),
+	).All(ctx, p.exec)
+	if err != nil {
+		return nil, err
 	}
+	return convertProjectV2ItemSlice(items), nil
+}
 
-	items, err := db.Projectcards(cond...).All(ctx, p.exec)
+// listProjectItems は、filterで絞り込んだProjectV2Itemのconnectionを返す
+func (p *projectItemService) listProjectItems(ctx context.Context, filter []qm.QueryMod, after *string, before *string, first *int, last *int) (*model.ProjectV2ItemConnection, error) {
+	items, err := paginate(ctx, connectionQuery[*db.Projectcard]{
+		maxPageSize:  p.maxPageSize,
+		cursorColumn: db.ProjectcardColumns.ID,
+		columns: []string{
+			db.ProjectcardColumns.ID,
+			db.ProjectcardColumns.Project,
+			db.ProjectcardColumns.Issue,
+			db.ProjectcardColumns.Pullrequest,
+			db.ProjectcardColumns.Archived,
+		},
+		filter: filter,
+		all: func(ctx context.Context, mods ...qm.QueryMod) ([]*db.Projectcard, error) {
+			return db.Projectcards(mods...).All(ctx, p.exec)
+		},
+		exists: func(ctx context.Context, mods ...qm.QueryMod) (bool, error) {
+			return db.Projectcards(mods...).Exists(ctx, p.exec)
+		},
+	}, after, before, first, last)
 	if err != nil {
 		return nil, err
 	}
 
-	var hasNextPage, hasPrevPage bool
-	if len(items) != 0 {
-		if scanDesc {
-			for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
-				items[i], items[j] = items[j], items[i]
-			}
-		}
-		startCursor, endCursor := items[0].ID, items[len(items)-1].ID
-
-		var err error
-		hasPrevPage, err = db.Projectcards(
-			db.ProjectcardWhere.Project.EQ(projectID),
-			db.ProjectcardWhere.ID.LT(startCursor),
-		).Exists(ctx, p.exec)
-		if err != nil {
-			return nil, err
-		}
-		hasNextPage, err = db.Projectcards(
-			db.ProjectcardWhere.Project.EQ(projectID),
-			db.ProjectcardWhere.ID.GT(endCursor),
-		).Exists(ctx, p.exec)
-		if err != nil {
-			return nil, err
-		}
-	}
+	return convertProjectV2ItemConnection(items.rows, items.hasPreviousPage, items.hasNextPage), nil
+}
 
-	return convertProjectV2ItemConnection(items, hasPrevPage, hasNextPage), nil
+func (p *projectItemService) ListProjectItemOwnedByProject(ctx context.Context, projectID string, after *string, before *string, first *int, last *int) (*model.ProjectV2ItemConnection, error) {
+	return p.listProjectItems(ctx, []qm.QueryMod{
+		db.ProjectcardWhere.Project.EQ(projectID),
+	}, after, before, first, last)
 }
 
 func (p *projectItemService) ListProjectItemOwnedByIssue(ctx context.Context, issueID string, after *string, before *string, first *int, last *int) (*model.ProjectV2ItemConnection, error) {
-	cond := []qm.QueryMod{
-		qm.Select(
-			db.ProjectcardColumns.ID,
-			db.ProjectcardColumns.Project,
-			db.ProjectcardColumns.Issue,
-			db.ProjectcardColumns.Pullrequest,
-		),
-		db.ProjectcardWhere.Pullrequest.EQ(null.StringFrom(issueID)),
+	return p.listProjectItems(ctx, []qm.QueryMod{
+		db.ProjectcardWhere.Issue.EQ(null.StringFrom(issueID)),
+	}, after, before, first, last)
+}
+
+func (p *projectItemService) ListProjectItemOwnedByPullRequest(ctx context.Context, pullRequestID string, after *string, before *string, first *int, last *int) (*model.ProjectV2ItemConnection, error) {
+	return p.listProjectItems(ctx, []qm.QueryMod{
+		db.ProjectcardWhere.Pullrequest.EQ(null.StringFrom(pullRequestID)),
+	}, after, before, first, last)
+}
+
+// authorizeProject は、ctxのユーザーがprojectIDのプロジェクトのアイテムを変更できることを確かめる
+func (p *projectItemService) authorizeProject(ctx context.Context, projectID string) error {
+	project, err := db.FindProject(ctx, p.exec, projectID, db.ProjectColumns.ID, db.ProjectColumns.Owner)
+	if err != nil {
+		return err
 	}
-	var scanDesc bool
-
-	switch {
-	case (after != nil) && (before != nil):
-		cond = append(cond, db.ProjectcardWhere.ID.GT(*after), db.ProjectcardWhere.ID.LT(*before))
-	case after != nil:
-		cond = append(cond,
-			db.ProjectcardWhere.ID.GT(*after),
-			qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectcardColumns.ID)),
-		)
-		if first != nil {
-			cond = append(cond, qm.Limit(*first))
-		}
-	case before != nil:
-		scanDesc = true
-		cond = append(cond,
-			db.ProjectcardWhere.ID.LT(*before),
-			qm.OrderBy(fmt.Sprintf("%s desc", db.ProjectcardColumns.ID)),
-		)
-		if last != nil {
-			cond = append(cond, qm.Limit(*last))
-		}
-	default:
-		switch {
-		case last != nil:
-			scanDesc = true
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s desc", db.ProjectcardColumns.ID)),
-				qm.Limit(*last),
-			)
-		case first != nil:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectcardColumns.ID)),
-				qm.Limit(*first),
-			)
-		default:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectcardColumns.ID)),
-			)
-		}
+	return policy.Authorize(policy.ProjectV2Write, facts(ctx, "", project.Owner))
+}
+
+// AddIssueInProjectV2 は、issueIDのIssueをプロジェクトに追加する
+// 既に追加されている場合は、既存のアイテムを返す
+func (p *projectItemService) AddIssueInProjectV2(ctx context.Context, projectID, issueID string) (*model.ProjectV2Item, error) {
+	if err := p.authorizeProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+	readable, err := db.Issues(
+		db.IssueWhere.ID.EQ(issueID),
+		readableRepository(ctx, db.IssueTableColumns.Repository),
+	).Exists(ctx, p.exec)
+	if err != nil {
+		return nil, err
+	}
+	if !readable {
+		return nil, apperrors.New(apperrors.NotFound, "issue not found")
 	}
 
-	items, err := db.Projectcards(cond...).All(ctx, p.exec)
+	return p.addProjectItem(ctx, &db.Projectcard{
+		ID:      globalid.Encode(globalid.ProjectV2Item, uuid.New().String()),
+		Project: projectID,
+		Issue:   null.StringFrom(issueID),
+	}, db.ProjectcardWhere.Issue.EQ(null.StringFrom(issueID)))
+}
+
+// AddPullRequestInProjectV2 は、pullRequestIDのPullRequestをプロジェクトに追加する
+// 既に追加されている場合は、既存のアイテムを返す
+func (p *projectItemService) AddPullRequestInProjectV2(ctx context.Context, projectID, pullRequestID string) (*model.ProjectV2Item, error) {
+	if err := p.authorizeProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+	readable, err := db.Pullrequests(
+		db.PullrequestWhere.ID.EQ(pullRequestID),
+		readableRepository(ctx, db.PullrequestTableColumns.Repository),
+	).Exists(ctx, p.exec)
 	if err != nil {
 		return nil, err
 	}
+	if !readable {
+		return nil, apperrors.New(apperrors.NotFound, "pull request not found")
+	}
+
+	return p.addProjectItem(ctx, &db.Projectcard{
+		ID:          globalid.Encode(globalid.ProjectV2Item, uuid.New().String()),
+		Project:     projectID,
+		Pullrequest: null.StringFrom(pullRequestID),
+	}, db.ProjectcardWhere.Pullrequest.EQ(null.StringFrom(pullRequestID)))
+}
+
+// addProjectItem は、sameContentに当てはまるアイテムがプロジェクトに無ければitemを追加する
+// 同じ内容のアイテムは1つのプロジェクトに1つだけなので、既にあればそれを返し、変更は通知しない
+func (p *projectItemService) addProjectItem(ctx context.Context, item *db.Projectcard, sameContent qm.QueryMod) (*model.ProjectV2Item, error) {
+	findExisting := func() (*db.Projectcard, error) {
+		return db.Projectcards(
+			db.ProjectcardWhere.Project.EQ(item.Project),
+			sameContent,
+		).One(ctx, p.exec)
+	}
+
+	existing, err := findExisting()
+	if err == nil {
+		return convertProjectV2Item(existing), nil
+	}
+	if !apperrors.IsNotFound(err) {
+		return nil, err
+	}
 
-	var hasNextPage, hasPrevPage bool
-	if len(items) != 0 {
-		if scanDesc {
-			for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
-				items[i], items[j] = items[j], items[i]
+	if err := item.Insert(ctx, p.exec, boil.Infer()); err != nil {
+		// 同時に追加されるとUNIQUE制約で弾かれるので、先に追加された方を返す
+		if apperrors.From(err).Code == apperrors.Conflict {
+			if existing, findErr := findExisting(); findErr == nil {
+				return convertProjectV2Item(existing), nil
 			}
 		}
-		startCursor, endCursor := items[0].ID, items[len(items)-1].ID
-
-		var err error
-		hasPrevPage, err = db.Projectcards(
-			db.ProjectcardWhere.Pullrequest.EQ(null.StringFrom(issueID)),
-			db.ProjectcardWhere.ID.LT(startCursor),
-		).Exists(ctx, p.exec)
-		if err != nil {
-			return nil, err
-		}
-		hasNextPage, err = db.Projectcards(
-			db.ProjectcardWhere.Pullrequest.EQ(null.StringFrom(issueID)),
-			db.ProjectcardWhere.ID.GT(endCursor),
-		).Exists(ctx, p.exec)
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
 	}
-
-	return convertProjectV2ItemConnection(items, hasPrevPage, hasNextPage), nil
+	result := convertProjectV2Item(item)
+	p.events.Publish(item.Project, &ProjectItemEvent{Type: ProjectItemAdded, Item: result})
+	return result, nil
 }
 
-func (p *projectItemService) ListProjectItemOwnedByPullRequest(ctx context.Context, pullRequestID string, after *string, before *string, first *int, last *int) (*model.ProjectV2ItemConnection, error) {
-	cond := []qm.QueryMod{
+// getProjectItemInProject は、projectIDのプロジェクトに属するアイテムだけを取得する
+func (p *projectItemService) getProjectItemInProject(ctx context.Context, projectID, itemID string) (*db.Projectcard, error) {
+	return db.Projectcards(
 		qm.Select(
 			db.ProjectcardColumns.ID,
 			db.ProjectcardColumns.Project,
 			db.ProjectcardColumns.Issue,
 			db.ProjectcardColumns.Pullrequest,
+			db.ProjectcardColumns.Archived,
 		),
-		db.ProjectcardWhere.Pullrequest.EQ(null.StringFrom(pullRequestID)),
-	}
-	var scanDesc bool
-
-	switch {
-	case (after != nil) && (before != nil):
-		cond = append(cond, db.ProjectcardWhere.ID.GT(*after), db.ProjectcardWhere.ID.LT(*before))
-	case after != nil:
-		cond = append(cond,
-			db.ProjectcardWhere.ID.GT(*after),
-			qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectcardColumns.ID)),
-		)
-		if first != nil {
-			cond = append(cond, qm.Limit(*first))
-		}
-	case before != nil:
-		scanDesc = true
-		cond = append(cond,
-			db.ProjectcardWhere.ID.LT(*before),
-			qm.OrderBy(fmt.Sprintf("%s desc", db.ProjectcardColumns.ID)),
-		)
-		if last != nil {
-			cond = append(cond, qm.Limit(*last))
-		}
-	default:
-		switch {
-		case last != nil:
-			scanDesc = true
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s desc", db.ProjectcardColumns.ID)),
-				qm.Limit(*last),
-			)
-		case first != nil:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectcardColumns.ID)),
-				qm.Limit(*first),
-			)
-		default:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectcardColumns.ID)),
-			)
-		}
-	}
+		db.ProjectcardWhere.ID.EQ(itemID),
+		db.ProjectcardWhere.Project.EQ(projectID),
+	).One(ctx, p.exec)
+}
 
-	items, err := db.Projectcards(cond...).All(ctx, p.exec)
+func (p *projectItemService) DeleteProjectV2Item(ctx context.Context, projectID, itemID string) error {
+	if err := p.authorizeProject(ctx, projectID); err != nil {
+		return err
+	}
+	item, err := p.getProjectItemInProject(ctx, projectID, itemID)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	var hasNextPage, hasPrevPage bool
-	if len(items) != 0 {
-		if scanDesc {
-			for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
-				items[i], items[j] = items[j], items[i]
-			}
-		}
-		startCursor, endCursor := items[0].ID, items[len(items)-1].ID
-
-		var err error
-		hasPrevPage, err = db.Projectcards(
-			db.ProjectcardWhere.Pullrequest.EQ(null.StringFrom(pullRequestID)),
-			db.ProjectcardWhere.ID.LT(startCursor),
-		).Exists(ctx, p.exec)
-		if err != nil {
-			return nil, err
-		}
-		hasNextPage, err = db.Projectcards(
-			db.ProjectcardWhere.Pullrequest.EQ(null.StringFrom(pullRequestID)),
-			db.ProjectcardWhere.ID.GT(endCursor),
-		).Exists(ctx, p.exec)
-		if err != nil {
-			return nil, err
-		}
+	if _, err := item.Delete(ctx, p.exec); err != nil {
+		return err
 	}
-
-	return convertProjectV2ItemConnection(items, hasPrevPage, hasNextPage), nil
+	p.events.Publish(projectID, &ProjectItemEvent{Type: ProjectItemRemoved, Item: convertProjectV2Item(item)})
+	return nil
 }
 
-func (p *projectItemService) AddIssueInProjectV2(ctx context.Context, projectID, issueID string) (*model.ProjectV2Item, error) {
-	itemID := uuid.New()
-	item := &db.Projectcard{
-		ID:      itemID.String(),
-		Project: projectID,
-		Issue:   null.StringFrom(issueID),
-	}
-	if err := item.Insert(ctx, p.exec, boil.Infer()); err != nil {
+func (p *projectItemService) SetProjectV2ItemArchived(ctx context.Context, projectID, itemID string, archived bool) (*model.ProjectV2Item, error) {
+	if err := p.authorizeProject(ctx, projectID); err != nil {
 		return nil, err
 	}
-	return convertProjectV2Item(item), nil
-}
-
-func (p *projectItemService) AddPullRequestInProjectV2(ctx context.Context, projectID, pullRequestID string) (*model.ProjectV2Item, error) {
-	itemID := uuid.New()
-	item := &db.Projectcard{
-		ID:          itemID.String(),
-		Project:     projectID,
-		Pullrequest: null.StringFrom(pullRequestID),
+	item, err := p.getProjectItemInProject(ctx, projectID, itemID)
+	if err != nil {
+		return nil, err
 	}
-	if err := item.Insert(ctx, p.exec, boil.Infer()); err != nil {
+	item.Archived = boolToInt64(archived)
+	if _, err := item.Update(ctx, p.exec, boil.Whitelist(db.ProjectcardColumns.Archived)); err != nil {
 		return nil, err
 	}
-	return convertProjectV2Item(item), nil
+	result := convertProjectV2Item(item)
+	p.events.Publish(projectID, &ProjectItemEvent{Type: ProjectItemUpdated, Item: result})
+	return result, nil
+}
+
+// SubscribeProjectItemEvents は、projectIDのプロジェクトのアイテムに対する変更を受け取るチャネルを返す
+// ctxが終了するとチャネルは閉じられる
+func (p *projectItemService) SubscribeProjectItemEvents(ctx context.Context, projectID string) <-chan *ProjectItemEvent {
+	return p.events.Subscribe(ctx, projectID)
 }
@@ -0,0 +1,173 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+)
+
+func TestProjectItemMutationsRequireProjectOwner(t *testing.T) {
+	db := newTestDB(t)
+	mustExec(t, db, `INSERT INTO users(id, name) VALUES ('U_1', 'hsaki'), ('U_2', 'octocat')`)
+	mustExec(t, db, `INSERT INTO projects(id, title, url, number, owner) VALUES ('PJ_1', 'My Project', '', 1, 'U_1')`)
+	mustExec(t, db, `INSERT INTO repositories(id, owner, name) VALUES ('REPO_1', 'U_1', 'repo1')`)
+	mustExec(t, db, `INSERT INTO issues(id, url, title, number, author, repository) VALUES ('ISSUE_1', '', '', 1, 'U_1', 'REPO_1')`)
+	srv := services.New(db)
+
+	if _, err := srv.AddIssueInProjectV2(as("U_2"), "PJ_1", "ISSUE_1"); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("want forbidden, but got %v", err)
+	}
+	item, err := srv.AddIssueInProjectV2(as("U_1"), "PJ_1", "ISSUE_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.SetProjectV2ItemArchived(as("U_2"), "PJ_1", item.ID, true); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("want forbidden, but got %v", err)
+	}
+	if err := srv.DeleteProjectV2Item(as("U_2"), "PJ_1", item.ID); apperrors.From(err).Code != apperrors.Forbidden {
+		t.Errorf("want forbidden, but got %v", err)
+	}
+	if err := srv.DeleteProjectV2Item(as("U_1"), "PJ_1", item.ID); err != nil {
+		t.Errorf("owner should be able to delete the item: %v", err)
+	}
+}
+
+func TestAddProjectItemIsIdempotent(t *testing.T) {
+	db := newPrivateRepoDB(t)
+	mustExec(t, db, `INSERT INTO projects(id, title, url, number, owner) VALUES ('PJ_1', 'My Project', '', 1, 'U_1'), ('PJ_3', 'Their Project', '', 1, 'U_3')`)
+	srv := services.New(db)
+	ctx, cancel := context.WithCancel(as("U_1"))
+	t.Cleanup(cancel)
+	events := srv.SubscribeProjectItemEvents(ctx, "PJ_1")
+
+	first, err := srv.AddIssueInProjectV2(ctx, "PJ_1", "ISSUE_P")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := srv.AddIssueInProjectV2(ctx, "PJ_1", "ISSUE_P")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID != second.ID {
+		t.Errorf("adding the same issue twice created another item: %s, %s", first.ID, second.ID)
+	}
+	if _, err := srv.AddPullRequestInProjectV2(ctx, "PJ_1", "PR_P"); err != nil {
+		t.Fatal(err)
+	}
+
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM projectcards WHERE project = 'PJ_1'`).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("want 2 items, but got %d", count)
+	}
+	// 追加されたのは2回だけなので、通知も2回だけ
+	for i := 0; i < 2; i++ {
+		if event := <-events; event.Type != services.ProjectItemAdded {
+			t.Errorf("unexpected event: %+v", event)
+		}
+	}
+	select {
+	case event := <-events:
+		t.Errorf("unexpected event: %+v", event)
+	default:
+	}
+
+	// 自分のプロジェクトでも、読めないIssueは追加できない
+	if _, err := srv.AddIssueInProjectV2(as("U_3"), "PJ_3", "ISSUE_P"); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+	if _, err := srv.AddPullRequestInProjectV2(as("U_3"), "PJ_3", "PR_P"); !apperrors.IsNotFound(err) {
+		t.Errorf("want not found, but got %v", err)
+	}
+}
+
+// racingExec は、projectcardsへの追加の直前に、同じ内容のアイテムを別の経路で追加する
+// 既存のアイテムの確認と追加の間に、他のリクエストが割り込んだ状況を作る
+type racingExec struct {
+	*sql.DB
+	race func()
+}
+
+func (e *racingExec) before(query string) {
+	if e.race != nil && strings.HasPrefix(query, `INSERT INTO "projectcards"`) {
+		e.race()
+		e.race = nil
+	}
+}
+
+func (e *racingExec) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e.before(query)
+	return e.DB.ExecContext(ctx, query, args...)
+}
+
+func (e *racingExec) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	e.before(query)
+	return e.DB.QueryRowContext(ctx, query, args...)
+}
+
+func TestAddProjectItemConflict(t *testing.T) {
+	db := newPrivateRepoDB(t)
+	mustExec(t, db, `INSERT INTO projects(id, title, url, number, owner) VALUES ('PJ_1', 'My Project', '', 1, 'U_1')`)
+
+	tests := []struct {
+		name string
+		add  func(srv services.Services, ctx context.Context) (string, error)
+		race string
+	}{
+		{
+			name: "issue",
+			add: func(srv services.Services, ctx context.Context) (string, error) {
+				item, err := srv.AddIssueInProjectV2(ctx, "PJ_1", "ISSUE_P")
+				if err != nil {
+					return "", err
+				}
+				return item.ID, nil
+			},
+			race: `INSERT INTO projectcards(id, project, issue) VALUES ('PJI_issue', 'PJ_1', 'ISSUE_P')`,
+		},
+		{
+			name: "pull request",
+			add: func(srv services.Services, ctx context.Context) (string, error) {
+				item, err := srv.AddPullRequestInProjectV2(ctx, "PJ_1", "PR_P")
+				if err != nil {
+					return "", err
+				}
+				return item.ID, nil
+			},
+			race: `INSERT INTO projectcards(id, project, pullrequest) VALUES ('PJI_pullrequest', 'PJ_1', 'PR_P')`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := &racingExec{DB: db, race: func() { mustExec(t, db, tt.race) }}
+			srv := services.New(exec)
+			ctx, cancel := context.WithCancel(as("U_1"))
+			t.Cleanup(cancel)
+			events := srv.SubscribeProjectItemEvents(ctx, "PJ_1")
+
+			// UNIQUE制約で弾かれた場合は、先に追加されたアイテムを返す
+			id, err := tt.add(srv, ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exec.race != nil {
+				t.Fatal("the racing insert did not run")
+			}
+			if want := "PJI_" + strings.ReplaceAll(tt.name, " ", ""); id != want {
+				t.Errorf("want %s, but got %s", want, id)
+			}
+			// 自分では追加していないので通知しない
+			select {
+			case event := <-events:
+				t.Errorf("unexpected event: %+v", event)
+			default:
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package services
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/saki-engineering/graphql-sample/graph/db"
@@ -13,7 +12,8 @@ import (
 )
 
 type projectService struct {
-	exec boil.ContextExecutor
+	exec        boil.ContextExecutor
+	maxPageSize int
 }
 
 func convertProjectV2(project *db.Project) *model.ProjectV2 {
@@ -31,6 +31,14 @@ func convertProjectV2(project *db.Project) *model.ProjectV2 {
 	}
 }
 
+func convertProjectV2Slice(projects db.ProjectSlice) []*model.ProjectV2 {
+	result := make([]*model.ProjectV2, 0, len(projects))
+	for _, project := range projects {
+		result = append(result, convertProjectV2(project))
+	}
+	return result
+}
+
 func convertProjectV2Connection(projects db.ProjectSlice, hasPrevPage, hasNextPage bool) *model.ProjectV2Connection {
 	var result model.ProjectV2Connection
 
@@ -85,8 +93,8 @@ func (p *projectService) GetProjectByOwnerAndNumber(ctx context.Context, ownerID
 	return convertProjectV2(project), nil
 }
 
-func (p *projectService) ListProjectByOwner(ctx context.Context, ownerID string, after *string, before *string, first *int, last *int) (*model.ProjectV2Connection, error) {
-	cond := []qm.QueryMod{
+func (p *projectService) ListProjectsByID(ctx context.Context, IDs []string) ([]*model.ProjectV2, error) {
+	projects, err := db.Projects(
 		qm.Select(
 			db.ProjectColumns.ID,
 			db.ProjectColumns.Title,
@@ -94,80 +102,38 @@ func (p *projectService) ListProjectByOwner(ctx context.Context, ownerID string,
 			db.ProjectColumns.URL,
 			db.ProjectColumns.Owner,
 		),
-		db.ProjectWhere.Owner.EQ(ownerID),
-	}
-	var scanDesc bool
-
-	switch {
-	case (after != nil) && (before != nil):
-		cond = append(cond, db.ProjectWhere.ID.GT(*after), db.ProjectWhere.ID.LT(*before))
-	case after != nil:
-		cond = append(cond,
-			db.ProjectWhere.ID.GT(*after),
-			qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectColumns.ID)),
-		)
-		if first != nil {
-			cond = append(cond, qm.Limit(*first))
-		}
-	case before != nil:
-		scanDesc = true
-		cond = append(cond,
-			db.ProjectWhere.ID.LT(*before),
-			qm.OrderBy(fmt.Sprintf("%s desc", db.ProjectColumns.ID)),
-		)
-		if last != nil {
-			cond = append(cond, qm.Limit(*last))
-		}
-	default:
-		switch {
-		case last != nil:
-			scanDesc = true
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s desc", db.ProjectColumns.ID)),
-				qm.Limit(*last),
-			)
-		case first != nil:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectColumns.ID)),
-				qm.Limit(*first),
-			)
-		default:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.ProjectColumns.ID)),
-			)
-		}
-	}
-
-	projects, err := db.Projects(cond...).All(ctx, p.exec)
+		db.ProjectWhere.ID.IN(IDs),
+	).All(ctx, p.exec)
 	if err != nil {
 		return nil, err
 	}
+	return convertProjectV2Slice(projects), nil
+}
 
-	var hasNextPage, hasPrevPage bool
-	if len(projects) != 0 {
-		if scanDesc {
-			for i, j := 0, len(projects)-1; i < j; i, j = i+1, j-1 {
-				projects[i], projects[j] = projects[j], projects[i]
-			}
-		}
-		startCursor, endCursor := projects[0].ID, projects[len(projects)-1].ID
-
-		var err error
-		hasPrevPage, err = db.Projects(
-			db.ProjectWhere.Owner.EQ(ownerID),
-			db.ProjectWhere.ID.LT(startCursor),
-		).Exists(ctx, p.exec)
-		if err != nil {
-			return nil, err
-		}
-		hasNextPage, err = db.Projects(
+func (p *projectService) ListProjectByOwner(ctx context.Context, ownerID string, after *string, before *string, first *int, last *int) (*model.ProjectV2Connection, error) {
+	projects, err := paginate(ctx, connectionQuery[*db.Project]{
+		maxPageSize:  p.maxPageSize,
+		cursorColumn: db.ProjectColumns.ID,
+		columns: []string{
+			db.ProjectColumns.ID,
+			db.ProjectColumns.Title,
+			db.ProjectColumns.Number,
+			db.ProjectColumns.URL,
+			db.ProjectColumns.Owner,
+		},
+		filter: []qm.QueryMod{
 			db.ProjectWhere.Owner.EQ(ownerID),
-			db.ProjectWhere.ID.GT(endCursor),
-		).Exists(ctx, p.exec)
-		if err != nil {
-			return nil, err
-		}
+		},
+		all: func(ctx context.Context, mods ...qm.QueryMod) ([]*db.Project, error) {
+			return db.Projects(mods...).All(ctx, p.exec)
+		},
+		exists: func(ctx context.Context, mods ...qm.QueryMod) (bool, error) {
+			return db.Projects(mods...).Exists(ctx, p.exec)
+		},
+	}, after, before, first, last)
+	if err != nil {
+		return nil, err
 	}
 
-	return convertProjectV2Connection(projects, hasPrevPage, hasNextPage), nil
+	return convertProjectV2Connection(projects.rows, projects.hasPreviousPage, projects.hasNextPage), nil
 }
@@ -2,18 +2,21 @@ package services
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/saki-engineering/graphql-sample/graph/db"
 	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/policy"
+	"github.com/saki-engineering/graphql-sample/graph/pubsub"
 
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type pullRequestService struct {
-	exec boil.ContextExecutor
+	exec        boil.ContextExecutor
+	maxPageSize int
+	events      *pubsub.Broker[*PullRequestEvent]
 }
 
 func convertPullRequest(pr *db.Pullrequest) *model.PullRequest {
@@ -33,6 +36,14 @@ func convertPullRequest(pr *db.Pullrequest) *model.PullRequest {
 	}
 }
 
+func convertPullRequestSlice(pullRequests db.PullrequestSlice) []*model.PullRequest {
+	result := make([]*model.PullRequest, 0, len(pullRequests))
+	for _, pr := range pullRequests {
+		result = append(result, convertPullRequest(pr))
+	}
+	return result
+}
+
 func convertPullRequestConnection(pullRequests db.PullrequestSlice, hasPrevPage, hasNextPage bool) *model.PullRequestConnection {
 	var result model.PullRequestConnection
 
@@ -55,16 +66,25 @@ func convertPullRequestConnection(pullRequests db.PullrequestSlice, hasPrevPage,
 	return &result
 }
 
+// getReadablePullRequest は、ctxのユーザーが読めるリポジトリのPullRequestだけを取得する
+func (p *pullRequestService) getReadablePullRequest(ctx context.Context, id string) (*db.Pullrequest, error) {
+	return db.Pullrequests(
+		qm.Select(
+			db.PullrequestColumns.ID,
+			db.PullrequestColumns.BaseRefName,
+			db.PullrequestColumns.Closed,
+			db.PullrequestColumns.HeadRefName,
+			db.PullrequestColumns.URL,
+			db.PullrequestColumns.Number,
+			db.PullrequestColumns.Repository,
+		),
+		db.PullrequestWhere.ID.EQ(id),
+		readableRepository(ctx, db.PullrequestTableColumns.Repository),
+	).One(ctx, p.exec)
+}
+
 func (p *pullRequestService) GetPullRequestByID(ctx context.Context, id string) (*model.PullRequest, error) {
-	pr, err := db.FindPullrequest(ctx, p.exec, id,
-		db.PullrequestColumns.ID,
-		db.PullrequestColumns.BaseRefName,
-		db.PullrequestColumns.Closed,
-		db.PullrequestColumns.HeadRefName,
-		db.PullrequestColumns.URL,
-		db.PullrequestColumns.Number,
-		db.PullrequestColumns.Repository,
-	)
+	pr, err := p.getReadablePullRequest(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +104,7 @@ func (p *pullRequestService) GetPullRequestByRepoAndNumber(ctx context.Context,
 		),
 		db.PullrequestWhere.Repository.EQ(repoID),
 		db.PullrequestWhere.Number.EQ(int64(number)),
+		readableRepository(ctx, db.PullrequestTableColumns.Repository),
 	).One(ctx, p.exec)
 	if err != nil {
 		return nil, err
@@ -91,8 +112,8 @@ func (p *pullRequestService) GetPullRequestByRepoAndNumber(ctx context.Context,
 	return convertPullRequest(pr), nil
 }
 
-func (p *pullRequestService) ListPullRequestInRepository(ctx context.Context, repoID string, after *string, before *string, first *int, last *int) (*model.PullRequestConnection, error) {
-	cond := []qm.QueryMod{
+func (p *pullRequestService) ListPullRequestsByID(ctx context.Context, IDs []string) ([]*model.PullRequest, error) {
+	pullRequests, err := db.Pullrequests(
 		qm.Select(
 			db.PullrequestColumns.ID,
 			db.PullrequestColumns.BaseRefName,
@@ -102,80 +123,83 @@ func (p *pullRequestService) ListPullRequestInRepository(ctx context.Context, re
 			db.PullrequestColumns.Number,
 			db.PullrequestColumns.Repository,
 		),
-		db.PullrequestWhere.Repository.EQ(repoID),
+		db.PullrequestWhere.ID.IN(IDs),
+		readableRepository(ctx, db.PullrequestTableColumns.Repository),
+	).All(ctx, p.exec)
+	if err != nil {
+		return nil, err
 	}
-	var scanDesc bool
-
-	switch {
-	case (after != nil) && (before != nil):
-		cond = append(cond, db.PullrequestWhere.ID.GT(*after), db.PullrequestWhere.ID.LT(*before))
-	case after != nil:
-		cond = append(cond,
-			db.PullrequestWhere.ID.GT(*after),
-			qm.OrderBy(fmt.Sprintf("%s asc", db.PullrequestColumns.ID)),
-		)
-		if first != nil {
-			cond = append(cond, qm.Limit(*first))
-		}
-	case before != nil:
-		scanDesc = true
-		cond = append(cond,
-			db.PullrequestWhere.ID.LT(*before),
-			qm.OrderBy(fmt.Sprintf("%s desc", db.PullrequestColumns.ID)),
-		)
-		if last != nil {
-			cond = append(cond, qm.Limit(*last))
-		}
-	default:
-		switch {
-		case last != nil:
-			scanDesc = true
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s desc", db.PullrequestColumns.ID)),
-				qm.Limit(*last),
-			)
-		case first != nil:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.PullrequestColumns.ID)),
-				qm.Limit(*first),
-			)
-		default:
-			cond = append(cond,
-				qm.OrderBy(fmt.Sprintf("%s asc", db.PullrequestColumns.ID)),
-			)
-		}
+	return convertPullRequestSlice(pullRequests), nil
+}
+
+func (p *pullRequestService) ListPullRequestInRepository(ctx context.Context, repoID string, after *string, before *string, first *int, last *int) (*model.PullRequestConnection, error) {
+	pullRequests, err := paginate(ctx, connectionQuery[*db.Pullrequest]{
+		maxPageSize:  p.maxPageSize,
+		cursorColumn: db.PullrequestColumns.ID,
+		columns: []string{
+			db.PullrequestColumns.ID,
+			db.PullrequestColumns.BaseRefName,
+			db.PullrequestColumns.Closed,
+			db.PullrequestColumns.HeadRefName,
+			db.PullrequestColumns.URL,
+			db.PullrequestColumns.Number,
+			db.PullrequestColumns.Repository,
+		},
+		filter: []qm.QueryMod{
+			db.PullrequestWhere.Repository.EQ(repoID),
+			readableRepository(ctx, db.PullrequestTableColumns.Repository),
+		},
+		all: func(ctx context.Context, mods ...qm.QueryMod) ([]*db.Pullrequest, error) {
+			return db.Pullrequests(mods...).All(ctx, p.exec)
+		},
+		exists: func(ctx context.Context, mods ...qm.QueryMod) (bool, error) {
+			return db.Pullrequests(mods...).Exists(ctx, p.exec)
+		},
+	}, after, before, first, last)
+	if err != nil {
+		return nil, err
 	}
 
-	pullRequests, err := db.Pullrequests(cond...).All(ctx, p.exec)
+	return convertPullRequestConnection(pullRequests.rows, pullRequests.hasPreviousPage, pullRequests.hasNextPage), nil
+}
+
+// UpdatePullRequest は、nilでない値だけを更新する
+// 実際に値が変わった場合のみ変更を通知する
+// 更新できるかはpolicy.PullRequestUpdateで決める
+func (p *pullRequestService) UpdatePullRequest(ctx context.Context, id string, baseRefName *string, closed *bool) (*model.PullRequest, error) {
+	pr, err := p.getReadablePullRequest(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if err := authorize(ctx, p.exec, policy.PullRequestUpdate, pr.Repository, ""); err != nil {
+		return nil, err
+	}
 
-	var hasNextPage, hasPrevPage bool
-	if len(pullRequests) != 0 {
-		if scanDesc {
-			for i, j := 0, len(pullRequests)-1; i < j; i, j = i+1, j-1 {
-				pullRequests[i], pullRequests[j] = pullRequests[j], pullRequests[i]
-			}
-		}
-		startCursor, endCursor := pullRequests[0].ID, pullRequests[len(pullRequests)-1].ID
-
-		var err error
-		hasPrevPage, err = db.Pullrequests(
-			db.PullrequestWhere.Repository.EQ(repoID),
-			db.PullrequestWhere.ID.LT(startCursor),
-		).Exists(ctx, p.exec)
-		if err != nil {
-			return nil, err
-		}
-		hasNextPage, err = db.Pullrequests(
-			db.PullrequestWhere.Repository.EQ(repoID),
-			db.PullrequestWhere.ID.GT(endCursor),
-		).Exists(ctx, p.exec)
-		if err != nil {
-			return nil, err
-		}
+	var columns, fields []string
+	if baseRefName != nil && *baseRefName != pr.BaseRefName {
+		pr.BaseRefName = *baseRefName
+		columns = append(columns, db.PullrequestColumns.BaseRefName)
+		fields = append(fields, "baseRefName")
+	}
+	if closed != nil && *closed != (pr.Closed == 1) {
+		pr.Closed = boolToInt64(*closed)
+		columns = append(columns, db.PullrequestColumns.Closed)
+		fields = append(fields, "closed")
 	}
+	if len(columns) == 0 {
+		return convertPullRequest(pr), nil
+	}
+
+	if _, err := pr.Update(ctx, p.exec, boil.Whitelist(columns...)); err != nil {
+		return nil, err
+	}
+	result := convertPullRequest(pr)
+	p.events.Publish(pr.Repository, &PullRequestEvent{Action: ChangeUpdated, ChangedFields: fields, PullRequest: result})
+	return result, nil
+}
 
-	return convertPullRequestConnection(pullRequests, hasPrevPage, hasNextPage), nil
+// SubscribePullRequestEvents は、repoIDのリポジトリのPullRequestの作成・変更を受け取るチャネルを返す
+// ctxが終了するとチャネルは閉じられる
+func (p *pullRequestService) SubscribePullRequestEvents(ctx context.Context, repoID string) <-chan *PullRequestEvent {
+	return p.events.Subscribe(ctx, repoID)
 }
@@ -16,17 +16,34 @@ type repoService struct {
 
 func convertRepository(repo *db.Repository) *model.Repository {
 	return &model.Repository{
-		ID:        repo.ID,
-		Owner:     &model.User{ID: repo.Owner},
-		Name:      repo.Name,
-		CreatedAt: repo.CreatedAt,
+		ID:         repo.ID,
+		Owner:      &model.User{ID: repo.Owner},
+		Name:       repo.Name,
+		Visibility: model.RepositoryVisibility(repo.Visibility),
+		CreatedAt:  repo.CreatedAt,
 	}
 }
 
+func convertRepositorySlice(repos db.RepositorySlice) []*model.Repository {
+	result := make([]*model.Repository, 0, len(repos))
+	for _, repo := range repos {
+		result = append(result, convertRepository(repo))
+	}
+	return result
+}
+
 func (r *repoService) GetRepoByID(ctx context.Context, id string) (*model.Repository, error) {
-	repo, err := db.FindRepository(ctx, r.exec, id,
-		db.RepositoryColumns.ID, db.RepositoryColumns.Name, db.RepositoryColumns.Owner, db.RepositoryColumns.CreatedAt,
-	)
+	repo, err := db.Repositories(
+		qm.Select(
+			db.RepositoryColumns.ID,
+			db.RepositoryColumns.Name,
+			db.RepositoryColumns.Owner,
+			db.RepositoryColumns.Visibility,
+			db.RepositoryColumns.CreatedAt,
+		),
+		db.RepositoryWhere.ID.EQ(id),
+		readableRepository(ctx, db.RepositoryTableColumns.ID),
+	).One(ctx, r.exec)
 	if err != nil {
 		return nil, err
 	}
@@ -39,13 +56,39 @@ func (r *repoService) GetRepoByFullName(ctx context.Context, owner, name string)
 			db.RepositoryColumns.ID,
 			db.RepositoryColumns.Name,
 			db.RepositoryColumns.Owner,
+			db.RepositoryColumns.Visibility,
 			db.RepositoryColumns.CreatedAt,
 		),
 		db.RepositoryWhere.Owner.EQ(owner),
 		db.RepositoryWhere.Name.EQ(name),
+		readableRepository(ctx, db.RepositoryTableColumns.ID),
 	).One(ctx, r.exec)
 	if err != nil {
 		return nil, err
 	}
 	return convertRepository(repo), nil
 }
+
+func (r *repoService) ListReposByID(ctx context.Context, IDs []string) ([]*model.Repository, error) {
+	repos, err := db.Repositories(
+		qm.Select(
+			db.RepositoryColumns.ID,
+			db.RepositoryColumns.Name,
+			db.RepositoryColumns.Owner,
+			db.RepositoryColumns.Visibility,
+			db.RepositoryColumns.CreatedAt,
+		),
+		db.RepositoryWhere.ID.IN(IDs),
+		readableRepository(ctx, db.RepositoryTableColumns.ID),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	return convertRepositorySlice(repos), nil
+}
+
+// GetViewerPermission は、ctxのユーザーがrepoIDのリポジトリに持つ権限を返す
+// 読めないリポジトリの場合は空文字を返す
+func (r *repoService) GetViewerPermission(ctx context.Context, repoID string) (model.RepositoryPermission, error) {
+	return viewerPermission(ctx, r.exec, repoID)
+}
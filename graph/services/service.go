@@ -2,8 +2,12 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/pubsub"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
 
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
@@ -16,6 +20,11 @@ type Services interface {
 	PullRequestService
 	ProjectService
 	ProjectItemService
+	PersonalAccessTokenService
+	AccountService
+	ImpersonationService
+	AuditLogService
+	InteractionService
 }
 
 type UserService interface {
@@ -27,33 +36,85 @@ type UserService interface {
 type RepoService interface {
 	GetRepoByID(ctx context.Context, id string) (*model.Repository, error)
 	GetRepoByFullName(ctx context.Context, owner, name string) (*model.Repository, error)
+	ListReposByID(ctx context.Context, IDs []string) ([]*model.Repository, error)
+	GetViewerPermission(ctx context.Context, repoID string) (model.RepositoryPermission, error)
+	GetRepositoryInvitation(ctx context.Context, id string) (*model.RepositoryInvitation, error)
+	ListViewerRepositoryInvitations(ctx context.Context) ([]*model.RepositoryInvitation, error)
+	InviteCollaborator(ctx context.Context, repoID, inviteeID string, permission model.RepositoryPermission) (*model.RepositoryInvitation, error)
+	AcceptRepositoryInvitation(ctx context.Context, id string) (*model.Repository, error)
+	DeclineRepositoryInvitation(ctx context.Context, id string) error
 }
 
 type IssueService interface {
 	GetIssueByID(ctx context.Context, id string) (*model.Issue, error)
 	GetIssueByRepoAndNumber(ctx context.Context, repoID string, number int) (*model.Issue, error)
+	ListIssuesByID(ctx context.Context, IDs []string) ([]*model.Issue, error)
 	ListIssueInRepository(ctx context.Context, repoID string, after *string, before *string, first *int, last *int) (*model.IssueConnection, error)
+	CreateIssue(ctx context.Context, repoID, authorID, title string) (*model.Issue, error)
+	UpdateIssue(ctx context.Context, id string, title *string, closed *bool) (*model.Issue, error)
+	SubscribeIssueEvents(ctx context.Context, repoID string) <-chan *IssueEvent
 }
 
 type PullRequestService interface {
 	GetPullRequestByID(ctx context.Context, id string) (*model.PullRequest, error)
 	GetPullRequestByRepoAndNumber(ctx context.Context, repoID string, number int) (*model.PullRequest, error)
+	ListPullRequestsByID(ctx context.Context, IDs []string) ([]*model.PullRequest, error)
 	ListPullRequestInRepository(ctx context.Context, repoID string, after *string, before *string, first *int, last *int) (*model.PullRequestConnection, error)
+	UpdatePullRequest(ctx context.Context, id string, baseRefName *string, closed *bool) (*model.PullRequest, error)
+	SubscribePullRequestEvents(ctx context.Context, repoID string) <-chan *PullRequestEvent
 }
 
 type ProjectService interface {
 	GetProjectByID(ctx context.Context, id string) (*model.ProjectV2, error)
 	GetProjectByOwnerAndNumber(ctx context.Context, ownerID string, number int) (*model.ProjectV2, error)
+	ListProjectsByID(ctx context.Context, IDs []string) ([]*model.ProjectV2, error)
 	ListProjectByOwner(ctx context.Context, ownerID string, after *string, before *string, first *int, last *int) (*model.ProjectV2Connection, error)
 }
 
 type ProjectItemService interface {
 	GetProjectItemByID(ctx context.Context, id string) (*model.ProjectV2Item, error)
+	ListProjectItemsByID(ctx context.Context, IDs []string) ([]*model.ProjectV2Item, error)
 	ListProjectItemOwnedByProject(ctx context.Context, projectID string, after *string, before *string, first *int, last *int) (*model.ProjectV2ItemConnection, error)
 	ListProjectItemOwnedByIssue(ctx context.Context, issueID string, after *string, before *string, first *int, last *int) (*model.ProjectV2ItemConnection, error)
 	ListProjectItemOwnedByPullRequest(ctx context.Context, pullRequestID string, after *string, before *string, first *int, last *int) (*model.ProjectV2ItemConnection, error)
 	AddIssueInProjectV2(ctx context.Context, projectID, issueID string) (*model.ProjectV2Item, error)
 	AddPullRequestInProjectV2(ctx context.Context, projectID, pullRequestID string) (*model.ProjectV2Item, error)
+	DeleteProjectV2Item(ctx context.Context, projectID, itemID string) error
+	SetProjectV2ItemArchived(ctx context.Context, projectID, itemID string, archived bool) (*model.ProjectV2Item, error)
+	SubscribeProjectItemEvents(ctx context.Context, projectID string) <-chan *ProjectItemEvent
+}
+
+type PersonalAccessTokenService interface {
+	GetPersonalAccessToken(ctx context.Context, ownerID, id string) (*model.PersonalAccessToken, error)
+	CreatePersonalAccessToken(ctx context.Context, ownerID, name string, scopes scope.Set, expiresAt *time.Time) (*model.PersonalAccessToken, string, error)
+	RevokePersonalAccessToken(ctx context.Context, ownerID, id string) (*model.PersonalAccessToken, error)
+	AuthenticateToken(ctx context.Context, token string) (*model.User, scope.Set, error)
+}
+
+type AccountService interface {
+	SignUp(ctx context.Context, name, password string) (*model.User, error)
+	Login(ctx context.Context, name, password string) (*model.User, *auth.Session, error)
+	Logout(ctx context.Context, token string) error
+	AuthenticateSession(ctx context.Context, token string) (*model.User, *auth.Session, error)
+}
+
+type ImpersonationService interface {
+	ImpersonateUser(ctx context.Context, name string) (*model.User, error)
+	RecordImpersonation(ctx context.Context, adminID, targetID, operationName string) error
+}
+
+type AuditLogService interface {
+	RecordAuditLog(ctx context.Context, entry *model.AuditLogEntry) error
+	GetAuditLogEntry(ctx context.Context, id string) (*model.AuditLogEntry, error)
+	ListAuditLog(ctx context.Context, filter *model.AuditLogFilter, after *string, first *int) (*model.AuditLogEntryConnection, error)
+}
+
+type InteractionService interface {
+	BlockUser(ctx context.Context, userID string) (*model.User, error)
+	UnblockUser(ctx context.Context, userID string) (*model.User, error)
+	IsBlockedByViewer(ctx context.Context, userID string) (bool, error)
+	GetRepositoryInteractionAbility(ctx context.Context, repoID string) (*model.RepositoryInteractionAbility, error)
+	SetRepositoryInteractionLimit(ctx context.Context, repoID string, limit model.RepositoryInteractionLimit, expiry model.RepositoryInteractionLimitExpiry) (*model.Repository, error)
 }
 
 type services struct {
@@ -63,15 +124,71 @@ type services struct {
 	*pullRequestService
 	*projectService
 	*projectItemService
+	*personalAccessTokenService
+	*accountService
+	*impersonationService
+	*auditLogService
+	*interactionService
 }
 
-func New(exec boil.ContextExecutor) Services {
+// DefaultMaxPageSize は、connectionが1度に返せる要素数の上限のデフォルト値
+const DefaultMaxPageSize = 100
+
+type options struct {
+	maxPageSize int
+	now         func() time.Time
+}
+
+type Option func(*options)
+
+// WithMaxPageSize は、connectionのfirst/lastに指定できる値の上限を設定する
+func WithMaxPageSize(size int) Option {
+	return func(o *options) {
+		o.maxPageSize = size
+	}
+}
+
+// WithClock は、期限の判定や記録する時刻に使う現在時刻の取得方法を設定する
+func WithClock(now func() time.Time) Option {
+	return func(o *options) {
+		o.now = now
+	}
+}
+
+func New(exec boil.ContextExecutor, opts ...Option) Services {
+	o := options{
+		maxPageSize: DefaultMaxPageSize,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return &services{
-		userService:        &userService{exec: exec},
-		repoService:        &repoService{exec: exec},
-		issueService:       &issueService{exec: exec},
-		pullRequestService: &pullRequestService{exec: exec},
-		projectService:     &projectService{exec: exec},
-		projectItemService: &projectItemService{exec: exec},
+		userService:                &userService{exec: exec},
+		repoService:                &repoService{exec: exec},
+		issueService:               &issueService{exec: exec, maxPageSize: o.maxPageSize, now: o.now, events: pubsub.New[*IssueEvent]()},
+		pullRequestService:         &pullRequestService{exec: exec, maxPageSize: o.maxPageSize, events: pubsub.New[*PullRequestEvent]()},
+		projectService:             &projectService{exec: exec, maxPageSize: o.maxPageSize},
+		projectItemService:         &projectItemService{exec: exec, maxPageSize: o.maxPageSize, events: pubsub.New[*ProjectItemEvent]()},
+		personalAccessTokenService: &personalAccessTokenService{exec: exec, now: o.now},
+		accountService:             &accountService{exec: exec, now: o.now, sessionTTL: DefaultSessionTTL},
+		impersonationService:       &impersonationService{exec: exec, now: o.now},
+		auditLogService:            &auditLogService{exec: exec, maxPageSize: o.maxPageSize, now: o.now},
+		interactionService:         &interactionService{exec: exec, now: o.now},
+	}
+}
+
+// beginTx は、execがトランザクションを張れる場合はトランザクションを開始する
+// 既にトランザクションの中にいる場合などは、execをそのまま使う
+func beginTx(ctx context.Context, exec boil.ContextExecutor) (boil.ContextExecutor, func() error, func(), error) {
+	beginner, ok := exec.(boil.ContextBeginner)
+	if !ok {
+		return exec, func() error { return nil }, func() {}, nil
+	}
+	tx, err := beginner.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, nil, err
 	}
+	return tx, tx.Commit, func() { tx.Rollback() }, nil
 }
@@ -16,8 +16,9 @@ type userService struct {
 
 func convertUser(user *db.User) *model.User {
 	return &model.User{
-		ID:   user.ID,
-		Name: user.Name,
+		ID:          user.ID,
+		Name:        user.Name,
+		IsSiteAdmin: user.IsSiteAdmin,
 	}
 }
 
@@ -31,7 +32,7 @@ func convertUserSlice(users db.UserSlice) []*model.User {
 
 func (u *userService) GetUserByID(ctx context.Context, id string) (*model.User, error) {
 	user, err := db.FindUser(ctx, u.exec, id,
-		db.UserTableColumns.ID, db.UserTableColumns.Name,
+		db.UserTableColumns.ID, db.UserTableColumns.Name, db.UserTableColumns.IsSiteAdmin,
 	)
 	if err != nil {
 		return nil, err
@@ -41,7 +42,7 @@ func (u *userService) GetUserByID(ctx context.Context, id string) (*model.User,
 
 func (u *userService) GetUserByName(ctx context.Context, name string) (*model.User, error) {
 	user, err := db.Users(
-		qm.Select(db.UserTableColumns.ID, db.UserTableColumns.Name),
+		qm.Select(db.UserTableColumns.ID, db.UserTableColumns.Name, db.UserTableColumns.IsSiteAdmin),
 		db.UserWhere.Name.EQ(name),
 		// qm.Where("name = ?", name),
 	).One(ctx, u.exec)
@@ -53,7 +54,7 @@ func (u *userService) GetUserByName(ctx context.Context, name string) (*model.Us
 
 func (u *userService) ListUsersByID(ctx context.Context, IDs []string) ([]*model.User, error) {
 	users, err := db.Users(
-		qm.Select(db.UserTableColumns.ID, db.UserTableColumns.Name),
+		qm.Select(db.UserTableColumns.ID, db.UserTableColumns.Name, db.UserTableColumns.IsSiteAdmin),
 		db.UserWhere.ID.IN(IDs),
 	).All(ctx, u.exec)
 	if err != nil {
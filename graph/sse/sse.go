@@ -0,0 +1,155 @@
+// Package sse は、GraphQL over Server-Sent Events (graphql-sse) のトランスポートを提供する
+// WebSocketのupgradeが通らない環境のため、distinct connectionsモードのみに対応する
+// オペレーションはJSONのPOSTか、クエリパラメータを付けたGETで送る
+// https://github.com/enisdenjo/graphql-sse/blob/master/PROTOCOL.md
+package sse
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type Transport struct{}
+
+var _ graphql.Transport = Transport{}
+
+// Supports は、text/event-streamを受け付けるGETとJSONのPOSTを扱う
+// 通常のGET・POSTトランスポートより先に登録する必要がある
+func (t Transport) Supports(r *http.Request) bool {
+	if !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		return false
+	}
+	if r.Method == http.MethodGet {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return r.Method == http.MethodPost && mediaType == "application/json"
+}
+
+func (t Transport) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	ctx := r.Context()
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		transport.SendErrorf(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	start := graphql.Now()
+	params, err := readParams(r)
+	if err != nil {
+		log.Printf("decoding error: %+v", err)
+		transport.SendErrorf(w, http.StatusBadRequest, "%s", err)
+		return
+	}
+	params.Headers = r.Header
+	params.ReadTime = graphql.TraceTiming{
+		Start: start,
+		End:   graphql.Now(),
+	}
+
+	rc, opErr := exec.CreateOperationContext(ctx, params)
+	ctx = graphql.WithOperationContext(ctx, rc)
+	// ストリームを始める前に、実行できないオペレーションは通常のJSONのエラーで返す
+	if opErr != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusFor(opErr))
+		if err := json.NewEncoder(w).Encode(exec.DispatchError(ctx, opErr)); err != nil {
+			log.Println(err)
+		}
+		return
+	}
+	// GETはCSRFの検査を通らないので、mutationは受け付けない
+	if r.Method == http.MethodGet && rc.Operation.Operation == ast.Mutation {
+		w.Header().Set("Allow", http.MethodPost)
+		transport.SendErrorf(w, http.StatusMethodNotAllowed, "mutations are not allowed over GET")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	// ヘッダーだけ先に送り、購読が始まったことをクライアントに知らせる
+	fmt.Fprint(w, ":\n\n")
+	flusher.Flush()
+
+	var responses graphql.ResponseHandler
+	responses, ctx = exec.DispatchOperation(ctx, rc)
+	for {
+		response := responses(ctx)
+		if response == nil {
+			break
+		}
+		writeEvent(w, response)
+		flusher.Flush()
+	}
+
+	fmt.Fprint(w, "event: complete\ndata:\n\n")
+	flusher.Flush()
+}
+
+// readParams は、POSTはJSONのボディから、GETはクエリパラメータからオペレーションを読む
+func readParams(r *http.Request) (*graphql.RawParams, error) {
+	params := &graphql.RawParams{}
+	if r.Method != http.MethodGet {
+		if err := decodeJSON(r.Body, params); err != nil {
+			return nil, fmt.Errorf("json request body could not be decoded: %w", err)
+		}
+		return params, nil
+	}
+
+	query := r.URL.Query()
+	params.Query = query.Get("query")
+	params.OperationName = query.Get("operationName")
+	if variables := query.Get("variables"); variables != "" {
+		if err := decodeJSON(strings.NewReader(variables), &params.Variables); err != nil {
+			return nil, errors.New("variables could not be decoded")
+		}
+	}
+	if extensions := query.Get("extensions"); extensions != "" {
+		if err := decodeJSON(strings.NewReader(extensions), &params.Extensions); err != nil {
+			return nil, errors.New("extensions could not be decoded")
+		}
+	}
+	return params, nil
+}
+
+func decodeJSON(r io.Reader, v interface{}) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// statusFor は、実行する前に拒否したオペレーションのステータスを返す
+// 構文やバリデーションのエラーは通常のPOSTと同じく422にする
+// 通常のPOSTが200で返すコストの上限などのエラーも、ストリームを始めていないので400にする
+func statusFor(errs gqlerror.List) int {
+	if errcode.GetErrorKind(errs) == errcode.KindProtocol {
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusBadRequest
+}
+
+func writeEvent(w io.Writer, response *graphql.Response) {
+	b, err := json.Marshal(response)
+	if err != nil {
+		b, _ = json.Marshal(&graphql.Response{Errors: gqlerror.List{{Message: err.Error()}}})
+	}
+	fmt.Fprintf(w, "event: next\ndata: %s\n\n", b)
+}
@@ -0,0 +1,59 @@
+package graph
+
+import (
+	"context"
+	"log"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/globalid"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/services"
+)
+
+// forwardEvents は、eventsのうちconvertがtrueを返したものだけを変換して流す
+// eventsはctxが終了すると閉じられるものとする
+func forwardEvents[E, T any](ctx context.Context, events <-chan E, convert func(E) (T, bool)) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for event := range events {
+			v, ok := convert(event)
+			if !ok {
+				continue
+			}
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// validateSubscribedRepository は、購読対象のリポジトリが存在し、ctxのユーザーが読めることを確かめる
+func (r *Resolver) validateSubscribedRepository(ctx context.Context, repoID string) error {
+	if _, err := globalid.DecodeAs(repoID, globalid.Repository); err != nil {
+		return err
+	}
+	_, err := r.getNode(ctx, repoID)
+	return err
+}
+
+// stillReadable は、イベントを流す前に、購読者がその対象を今も読めるかを確かめる
+// 購読を始めた後にcollaboratorから外された場合などに、読めなくなった対象のイベントを流さないようにする
+func stillReadable(ctx context.Context, fetch func(ctx context.Context, id string) error, id string) bool {
+	err := fetch(ctx, id)
+	if err == nil {
+		return true
+	}
+	if !apperrors.IsNotFound(err) {
+		log.Printf("failed to check visibility of %s: %v", id, err)
+	}
+	return false
+}
+
+var changeActions = map[services.ChangeAction]model.ChangeAction{
+	services.ChangeCreated: model.ChangeActionCreated,
+	services.ChangeUpdated: model.ChangeActionUpdated,
+}
@@ -0,0 +1,133 @@
+// Package trusteddocs は、ビルド時に登録されたオペレーション(trusted documents)だけを実行させるgqlgenの拡張を提供する
+// クライアントはextensions.persistedQuery.sha256Hashにマニフェストのidを入れて送る
+package trusteddocs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type Mode int
+
+const (
+	// Enforce は、登録されていないオペレーションを拒否する
+	Enforce Mode = iota
+	// ReportOnly は、登録されていないオペレーションをログに出すだけで実行はする
+	// 本番に適用する前に、違反がないかを確かめるためのもの
+	ReportOnly
+)
+
+// ParseMode は、環境変数などで指定されたモード名を解釈する
+func ParseMode(s string) (Mode, error) {
+	switch s {
+	case "", "enforce":
+		return Enforce, nil
+	case "report":
+		return ReportOnly, nil
+	default:
+		return 0, fmt.Errorf("unknown trusted documents mode: %q", s)
+	}
+}
+
+const (
+	codeDocumentNotFound = "PERSISTED_QUERY_NOT_FOUND"
+	codeDocumentRequired = "TRUSTED_DOCUMENT_REQUIRED"
+)
+
+type Extension struct {
+	mode Mode
+	// id → ドキュメント
+	documents map[string]string
+	// ドキュメント → id
+	ids map[string]string
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = &Extension{}
+
+func New(documents map[string]string, mode Mode) *Extension {
+	ids := make(map[string]string, len(documents))
+	for id, document := range documents {
+		ids[document] = id
+	}
+	return &Extension{
+		mode:      mode,
+		documents: documents,
+		ids:       ids,
+	}
+}
+
+// Load は、{"<id>": "<document>", ...} 形式のマニフェストを読み込む
+func Load(path string, mode Mode) (*Extension, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var documents map[string]string
+	if err := json.Unmarshal(b, &documents); err != nil {
+		return nil, fmt.Errorf("invalid trusted documents manifest %s: %w", path, err)
+	}
+	return New(documents, mode), nil
+}
+
+func (e *Extension) ExtensionName() string {
+	return "TrustedDocuments"
+}
+
+func (e *Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters は、idで指定されたオペレーションをマニフェストのドキュメントに置き換える
+// APQよりも先に実行されるよう、srv.Useで先に登録する必要がある
+func (e *Extension) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	if id, ok := documentID(rawParams); ok {
+		document, found := e.documents[id]
+		if found {
+			rawParams.Query = document
+			// APQとして改めて登録・検証されないようにする
+			delete(rawParams.Extensions, "persistedQuery")
+			return nil
+		}
+		if e.mode == ReportOnly {
+			log.Printf("trusted documents: unknown operation id %q (operation: %q)", id, rawParams.OperationName)
+			return nil
+		}
+		return trustedDocumentsError(codeDocumentNotFound, "unknown operation id %q", id)
+	}
+
+	// 登録済みのドキュメントと全く同じであれば、本文で送られても受け付ける
+	if _, ok := e.ids[rawParams.Query]; ok {
+		return nil
+	}
+	if e.mode == ReportOnly {
+		log.Printf("trusted documents: ad-hoc operation (operation: %q)", rawParams.OperationName)
+		return nil
+	}
+	return trustedDocumentsError(codeDocumentRequired, "only trusted documents can be executed")
+}
+
+func documentID(rawParams *graphql.RawParams) (string, bool) {
+	pq, ok := rawParams.Extensions["persistedQuery"].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	id, ok := pq["sha256Hash"].(string)
+	return id, ok && id != ""
+}
+
+func trustedDocumentsError(code, format string, args ...interface{}) *gqlerror.Error {
+	err := gqlerror.Errorf(format, args...)
+	err.Extensions = map[string]interface{}{
+		"code": code,
+	}
+	return err
+}
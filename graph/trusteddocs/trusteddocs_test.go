@@ -0,0 +1,114 @@
+package trusteddocs_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/trusteddocs"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const repoQuery = `query Repo { repository(owner: "hsaki", name: "repo1") { id } }`
+
+func withID(id string) map[string]interface{} {
+	return map[string]interface{}{
+		"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": id},
+	}
+}
+
+func TestMutateOperationParameters(t *testing.T) {
+	documents := map[string]string{"repo": repoQuery}
+
+	tests := []struct {
+		name      string
+		mode      trusteddocs.Mode
+		params    graphql.RawParams
+		wantQuery string
+		wantCode  string
+	}{
+		{
+			name:      "known id",
+			mode:      trusteddocs.Enforce,
+			params:    graphql.RawParams{Extensions: withID("repo")},
+			wantQuery: repoQuery,
+		},
+		{
+			name:     "unknown id",
+			mode:     trusteddocs.Enforce,
+			params:   graphql.RawParams{Extensions: withID("unknown")},
+			wantCode: "PERSISTED_QUERY_NOT_FOUND",
+		},
+		{
+			name:     "ad-hoc query",
+			mode:     trusteddocs.Enforce,
+			params:   graphql.RawParams{Query: `{ node(id: "U_1") { id } }`},
+			wantCode: "TRUSTED_DOCUMENT_REQUIRED",
+		},
+		{
+			name:      "registered query text",
+			mode:      trusteddocs.Enforce,
+			params:    graphql.RawParams{Query: repoQuery},
+			wantQuery: repoQuery,
+		},
+		{
+			name:      "unknown id in report mode",
+			mode:      trusteddocs.ReportOnly,
+			params:    graphql.RawParams{Extensions: withID("unknown")},
+			wantQuery: "",
+		},
+		{
+			name:      "ad-hoc query in report mode",
+			mode:      trusteddocs.ReportOnly,
+			params:    graphql.RawParams{Query: `{ node(id: "U_1") { id } }`},
+			wantQuery: `{ node(id: "U_1") { id } }`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ext := trusteddocs.New(documents, tt.mode)
+			params := tt.params
+
+			err := ext.MutateOperationParameters(context.Background(), &params)
+			if tt.wantCode != "" {
+				if err == nil {
+					t.Fatalf("want %s, but got nil", tt.wantCode)
+				}
+				if code := err.Extensions["code"]; code != tt.wantCode {
+					t.Errorf("want %s, but got %v", tt.wantCode, code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if params.Query != tt.wantQuery {
+				t.Errorf("want query %q, but got %q", tt.wantQuery, params.Query)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	if err := os.WriteFile(path, []byte(`{"repo": "query Repo { repository(owner: \"hsaki\", name: \"repo1\") { id } }"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ext, err := trusteddocs.Load(path, trusteddocs.Enforce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := graphql.RawParams{Extensions: withID("repo")}
+	if err := ext.MutateOperationParameters(context.Background(), &params); err != nil {
+		t.Fatal(err)
+	}
+	if params.Query != repoQuery {
+		t.Errorf("want query %q, but got %q", repoQuery, params.Query)
+	}
+	if _, ok := params.Extensions["persistedQuery"]; ok {
+		t.Error("persistedQuery extension should be removed")
+	}
+}
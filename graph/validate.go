@@ -0,0 +1,32 @@
+package graph
+
+import (
+	"strings"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+)
+
+// validateTitle は、タイトルが空白だけでないことを確かめる
+func validateTitle(title string) error {
+	if strings.TrimSpace(title) == "" {
+		return apperrors.New(apperrors.Validation, "title must not be blank")
+	}
+	return nil
+}
+
+// validateName は、名前が空白だけでないことを確かめる
+func validateName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return apperrors.New(apperrors.Validation, "name must not be blank")
+	}
+	return nil
+}
+
+// validatePassword は、パスワードの長さを確かめる
+// bcryptは72バイトより後ろを無視するので、それより長いパスワードは受け付けない
+func validatePassword(password string) error {
+	if len(password) < 8 || len(password) > 72 {
+		return apperrors.New(apperrors.Validation, "password must be between 8 and 72 bytes")
+	}
+	return nil
+}
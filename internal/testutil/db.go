@@ -0,0 +1,62 @@
+// Package testutil は、テストで使うDBを用意する
+package testutil
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// schemaStart は、setup.shのうちテーブルを作る部分の始まり
+const schemaStart = `echo "creating tables..."`
+
+// Schema は、setup.shからテーブルを作るSQLを取り出す
+// テストのスキーマがsetup.shとずれないよう、手で写さずに毎回読み込む
+func Schema(t testing.TB) string {
+	t.Helper()
+
+	_, file, _, _ := runtime.Caller(0)
+	script, err := os.ReadFile(filepath.Join(filepath.Dir(file), "..", "..", "setup.sh"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// sqlite3 ${DBFILE_NAME} "...." の引用符の中身を取り出す
+	s := string(script)
+	i := strings.Index(s, schemaStart)
+	if i < 0 {
+		t.Fatal("setup.sh does not create tables")
+	}
+	s = s[i+len(schemaStart):]
+	start := strings.Index(s, `"`)
+	end := strings.Index(s[start+1:], "\n\"")
+	if start < 0 || end < 0 {
+		t.Fatal("failed to find the schema in setup.sh")
+	}
+	// 行末の\はシェルの行継続なので、bashと同じく取り除いて行をつなげる
+	return strings.ReplaceAll(s[start+1:start+1+end], "\\\n", "")
+}
+
+// NewDB は、setup.shと同じスキーマを持つインメモリのDBを返す
+// 外部キー制約も有効になっているので、参照される行を先に入れる必要がある
+func NewDB(t testing.TB) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:?_foreign_keys=on")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// :memory: のDBはコネクションごとに別物になるので1本に絞る
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := db.Exec(Schema(t)); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
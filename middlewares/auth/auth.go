@@ -2,56 +2,150 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log"
 	"net/http"
 	"strings"
-)
 
-type userNameKey struct{}
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
 
-const (
-	tokenPrefix = "UT"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
-func AuthMiddleware(next http.Handler) http.Handler {
+type userKey struct{}
+
+type scopesKey struct{}
+
+// Authenticator は、Authorizationヘッダで送られたトークンの持ち主とスコープを返す
+// 認証できないトークンにはapperrors.Unauthenticatedのエラーを返す
+type Authenticator interface {
+	AuthenticateToken(ctx context.Context, token string) (*model.User, scope.Set, error)
+}
+
+const bearerPrefix = "Bearer "
+
+// AuthMiddleware は、Authorizationヘッダのトークンを検証し、持ち主のユーザーをctxに入れる
+// トークンがなければ、WithSessionsが指定されている場合はセッションのCookieで認証し、
+// それもなければ未認証のまま次に渡す
+// WithImpersonationが指定されている場合は、管理者のX-Impersonate-Userヘッダに従ってユーザーを差し替える
+func AuthMiddleware(authenticator Authenticator, next http.Handler, opts ...Option) http.Handler {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.impersonator != nil {
+		next = impersonate(o.impersonator, next)
+	}
+
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		token := req.Header.Get("Authorization")
+		// resolverがログイン・ログアウトでCookieを書き換えられるようにする
+		req = req.WithContext(withCookieWriter(req.Context(), w, req))
+
+		token := tokenFromHeader(req.Header.Get("Authorization"))
 		if token == "" {
+			if o.sessions != nil {
+				ctx, ok := authenticateSession(w, req, o.sessions)
+				if !ok {
+					return
+				}
+				req = req.WithContext(ctx)
+			}
 			next.ServeHTTP(w, req)
 			return
 		}
 
-		userName, err := validateToken(token)
+		user, scopes, err := authenticator.AuthenticateToken(req.Context(), token)
 		if err != nil {
-			log.Println(err)
-			http.Error(w, `{"reason": "invalid token"}`, http.StatusUnauthorized)
+			unauthorized(w, err)
 			return
 		}
 
-		ctx := context.WithValue(req.Context(), userNameKey{}, userName)
-		next.ServeHTTP(w, req.WithContext(ctx))
+		// GitHubと同じく、トークンに与えられたスコープをヘッダで知らせる
+		w.Header().Set("X-OAuth-Scopes", strings.Join(scopes.Strings(), ", "))
+		next.ServeHTTP(w, req.WithContext(WithUser(req.Context(), user, scopes)))
 	})
 }
 
-func GetUserName(ctx context.Context) (string, bool) {
-	switch v := ctx.Value(userNameKey{}).(type) {
-	case string:
-		return v, true
-	default:
-		return "", false
+// tokenFromHeader は、"Bearer <token>"と、prefixのないトークンのどちらも受け付ける
+func tokenFromHeader(header string) string {
+	if len(header) >= len(bearerPrefix) && strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return strings.TrimSpace(header[len(bearerPrefix):])
+	}
+	return strings.TrimSpace(header)
+}
+
+// unauthorized は、GraphQLのレスポンスと同じ形で401を返す
+func unauthorized(w http.ResponseWriter, err error) {
+	appErr := apperrors.From(err)
+	if appErr.Code != apperrors.Unauthenticated {
+		// DBのエラーなどは中身を見せずにログにだけ出す
+		log.Println("failed to authenticate token:", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", `Bearer realm="graphql", error="invalid_token"`)
+	w.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(w).Encode(&graphql.Response{
+		Errors: gqlerror.List{{
+			Message: "invalid token",
+			Extensions: map[string]interface{}{
+				"code": apperrors.Unauthenticated,
+			},
+		}},
+	})
+}
+
+// WebsocketInitFunc は、websocketのconnection_initで送られたAuthorizationを検証する
+// AuthMiddlewareと同じく、トークンがなければ未認証のまま接続を受け入れる
+func WebsocketInitFunc(authenticator Authenticator) transport.WebsocketInitFunc {
+	return func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		token := tokenFromHeader(initPayload.Authorization())
+		if token == "" {
+			return ctx, nil, nil
+		}
+
+		user, scopes, err := authenticator.AuthenticateToken(ctx, token)
+		if err != nil {
+			if apperrors.From(err).Code != apperrors.Unauthenticated {
+				log.Println("failed to authenticate token:", err)
+			}
+			return nil, nil, errors.New("invalid token")
+		}
+		return WithUser(ctx, user, scopes), nil, nil
 	}
 }
 
-func validateToken(token string) (string, error) {
-	tElems := strings.SplitN(token, "_", 2)
-	if len(tElems) < 2 {
-		return "", errors.New("invalid token")
+// WithUser は、userがscopesを持つトークンで認証されたものとしてctxに入れる
+func WithUser(ctx context.Context, user *model.User, scopes scope.Set) context.Context {
+	ctx = context.WithValue(ctx, userKey{}, user)
+	return context.WithValue(ctx, scopesKey{}, scopes)
+}
+
+// GetUser は、認証済みのユーザーを返す
+func GetUser(ctx context.Context) (*model.User, bool) {
+	user, ok := ctx.Value(userKey{}).(*model.User)
+	return user, ok && user != nil
+}
+
+func GetUserName(ctx context.Context) (string, bool) {
+	user, ok := GetUser(ctx)
+	if !ok {
+		return "", false
 	}
+	return user.Name, true
+}
 
-	tType, tUserName := tElems[0], tElems[1]
-	if tType != tokenPrefix {
-		return "", errors.New("invalid token")
+// GetScopes は、認証に使われたトークンのスコープを返す
+// 未認証の場合はfalseを返す
+func GetScopes(ctx context.Context) (scope.Set, bool) {
+	if _, ok := GetUser(ctx); !ok {
+		return nil, false
 	}
-	return tUserName, nil
+	scopes, _ := ctx.Value(scopesKey{}).(scope.Set)
+	return scopes, true
 }
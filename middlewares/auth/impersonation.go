@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+)
+
+// ImpersonateHeader は、管理者がなりすますユーザーの名前を指定するヘッダ
+const ImpersonateHeader = "X-Impersonate-User"
+
+// Impersonator は、ctxの管理者がなりすます先のユーザーを返す
+// 管理者でない場合はapperrors.Forbiddenのエラーを返す
+type Impersonator interface {
+	ImpersonateUser(ctx context.Context, name string) (*model.User, error)
+}
+
+// WithImpersonation は、管理者がImpersonateHeaderで他のユーザーになりすますことを許可する
+func WithImpersonation(impersonator Impersonator) Option {
+	return func(o *options) {
+		o.impersonator = impersonator
+	}
+}
+
+type impersonatorKey struct{}
+
+// GetImpersonator は、なりすましている場合に、なりすましている管理者を返す
+// GetUserは、なりすまされているユーザーを返す
+func GetImpersonator(ctx context.Context) (*model.User, bool) {
+	admin, ok := ctx.Value(impersonatorKey{}).(*model.User)
+	return admin, ok && admin != nil
+}
+
+// impersonate は、認証済みのユーザーがImpersonateHeaderを付けている場合に、ctxのユーザーを差し替える
+// スコープは管理者のトークンのものをそのまま使う
+func impersonate(impersonator Impersonator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		name := req.Header.Get(ImpersonateHeader)
+		if name == "" {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		ctx := req.Context()
+		admin, ok := GetUser(ctx)
+		if !ok {
+			writeError(w, http.StatusForbidden, apperrors.Forbidden, "not authenticated")
+			return
+		}
+		target, err := impersonator.ImpersonateUser(ctx, name)
+		if err != nil {
+			appErr := apperrors.From(err)
+			if appErr.Code == apperrors.Internal {
+				log.Println("failed to impersonate user:", err)
+				writeError(w, http.StatusInternalServerError, apperrors.Internal, "internal error")
+				return
+			}
+			writeError(w, http.StatusForbidden, appErr.Code, appErr.Message)
+			return
+		}
+
+		scopes, _ := GetScopes(ctx)
+		ctx = context.WithValue(ctx, impersonatorKey{}, admin)
+		next.ServeHTTP(w, req.WithContext(WithUser(ctx, target, scopes)))
+	})
+}
@@ -0,0 +1,85 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+)
+
+// impersonator は、管理者にだけoctocatへのなりすましを許可するImpersonator
+type impersonator struct{}
+
+func (impersonator) ImpersonateUser(ctx context.Context, name string) (*model.User, error) {
+	if viewer, _ := auth.GetUser(ctx); !viewer.IsSiteAdmin {
+		return nil, apperrors.New(apperrors.Forbidden, "viewer is not allowed to User.impersonate")
+	}
+	if name != "octocat" {
+		return nil, apperrors.New(apperrors.NotFound, "user %q not found", name)
+	}
+	return &model.User{ID: "U_2", Name: "octocat"}, nil
+}
+
+func TestImpersonation(t *testing.T) {
+	authenticator := tokens{
+		"admin-token": {ID: "U_1", Name: "hsaki", IsSiteAdmin: true},
+		"user-token":  {ID: "U_3", Name: "someone"},
+	}
+
+	tests := []struct {
+		name             string
+		token            string
+		impersonate      string
+		wantStatus       int
+		wantUser         string
+		wantImpersonator string
+	}{
+		{name: "admin without header", token: "admin-token", wantStatus: http.StatusOK, wantUser: "U_1"},
+		{name: "admin impersonates", token: "admin-token", impersonate: "octocat", wantStatus: http.StatusOK, wantUser: "U_2", wantImpersonator: "U_1"},
+		{name: "unknown target", token: "admin-token", impersonate: "nobody", wantStatus: http.StatusForbidden},
+		{name: "non-admin", token: "user-token", impersonate: "octocat", wantStatus: http.StatusForbidden},
+		{name: "anonymous", impersonate: "octocat", wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUser, gotImpersonator string
+			var gotScopes scope.Set
+			next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if user, ok := auth.GetUser(req.Context()); ok {
+					gotUser = user.ID
+				}
+				if admin, ok := auth.GetImpersonator(req.Context()); ok {
+					gotImpersonator = admin.ID
+				}
+				gotScopes, _ = auth.GetScopes(req.Context())
+			})
+			handler := auth.AuthMiddleware(authenticator, next, auth.WithImpersonation(impersonator{}))
+
+			req := httptest.NewRequest(http.MethodPost, "http://example.com/query", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			if tt.impersonate != "" {
+				req.Header.Set(auth.ImpersonateHeader, tt.impersonate)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("want status %d, but got %d", tt.wantStatus, rec.Code)
+			}
+			if gotUser != tt.wantUser || gotImpersonator != tt.wantImpersonator {
+				t.Errorf("want user=%q impersonator=%q, but got user=%q impersonator=%q", tt.wantUser, tt.wantImpersonator, gotUser, gotImpersonator)
+			}
+			// なりすましても、管理者のトークンのスコープをそのまま使う
+			if tt.wantUser != "" && !gotScopes.Has(scope.User) {
+				t.Errorf("unexpected scopes: %v", gotScopes)
+			}
+		})
+	}
+}
@@ -0,0 +1,420 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+)
+
+// jwtLeeway は、exp/nbfの検証で許容する時計のずれ
+const jwtLeeway = 30 * time.Second
+
+// DefaultUserClaim は、usersのnameと対応させるクレームのデフォルト
+const DefaultUserClaim = "sub"
+
+// minRSAKeyBits は、受け付けるRSAの鍵の最小の長さ
+const minRSAKeyBits = 2048
+
+type JWTConfig struct {
+	// JWKSFile は、検証に使う鍵を並べたJWKSファイルのパス
+	// ファイルが更新されると次の検証から新しい鍵を使う
+	JWKSFile string
+	// Issuer は、issクレームと一致しなければならない値。必須
+	Issuer string
+	// Audience は、audクレームに含まれなければならない値。必須
+	// 同じIdPが他のサービス向けに発行したトークンを受け付けないようにする
+	Audience string
+	// UserClaim は、値をusersのnameと対応させるクレーム
+	UserClaim string
+}
+
+// UserFinder は、クレームの値からユーザーを探す
+type UserFinder interface {
+	GetUserByName(ctx context.Context, name string) (*model.User, error)
+}
+
+// JWTAuthenticator は、外部のIdPが発行したJWTを検証する
+// スコープはOAuthと同じく、scopeクレームに空白区切りで入っているものを使う
+type JWTAuthenticator struct {
+	cfg   JWTConfig
+	users UserFinder
+	keys  *keySet
+	now   func() time.Time
+}
+
+var _ Authenticator = (*JWTAuthenticator)(nil)
+
+func NewJWTAuthenticator(cfg JWTConfig, users UserFinder) (*JWTAuthenticator, error) {
+	if cfg.Issuer == "" {
+		return nil, errors.New("JWT issuer is required")
+	}
+	if cfg.Audience == "" {
+		return nil, errors.New("JWT audience is required")
+	}
+	if cfg.UserClaim == "" {
+		cfg.UserClaim = DefaultUserClaim
+	}
+	keys := &keySet{path: cfg.JWKSFile}
+	// 起動時に読めない場合は設定の誤りなので、エラーにする
+	if err := keys.load(); err != nil {
+		return nil, err
+	}
+	return &JWTAuthenticator{
+		cfg:   cfg,
+		users: users,
+		keys:  keys,
+		now:   time.Now,
+	}, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+func (a *JWTAuthenticator) AuthenticateToken(ctx context.Context, token string) (*model.User, scope.Set, error) {
+	claims, err := a.verify(token)
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.Unauthenticated, "invalid token")
+	}
+
+	name, ok := claims[a.cfg.UserClaim].(string)
+	if !ok || name == "" {
+		return nil, nil, apperrors.New(apperrors.Unauthenticated, "invalid token")
+	}
+	user, err := a.users.GetUserByName(ctx, name)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, nil, apperrors.Wrap(err, apperrors.Unauthenticated, "invalid token")
+		}
+		return nil, nil, err
+	}
+
+	var scopes scope.Set
+	if s, ok := claims["scope"].(string); ok {
+		for _, f := range strings.Fields(s) {
+			// IdPが他のサービス向けに発行したスコープは無視する
+			if parsed, err := scope.Parse([]string{f}); err == nil {
+				scopes = append(scopes, parsed...)
+			}
+		}
+	}
+	return user, scopes, nil
+}
+
+// verify は、署名と登録済みクレームを検証してクレームを返す
+func (a *JWTAuthenticator) verify(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("signature: %w", err)
+	}
+	signed := []byte(parts[0] + "." + parts[1])
+
+	keys, err := a.keys.get()
+	if err != nil {
+		return nil, err
+	}
+	verified := false
+	for _, key := range keys {
+		if !key.accepts(header) {
+			continue
+		}
+		if key.verify(signed, sig) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("signature is not verified (alg=%q, kid=%q)", header.Alg, header.Kid)
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("claims: %w", err)
+	}
+	if err := a.validateClaims(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (a *JWTAuthenticator) validateClaims(claims map[string]interface{}) error {
+	now := a.now()
+
+	// 期限のないトークンは受け付けない
+	exp, ok := numericDate(claims["exp"])
+	if !ok {
+		return errors.New("exp is missing")
+	}
+	if !now.Before(exp.Add(jwtLeeway)) {
+		return errors.New("token is expired")
+	}
+	if nbf, ok := numericDate(claims["nbf"]); ok && now.Add(jwtLeeway).Before(nbf) {
+		return errors.New("token is not valid yet")
+	}
+
+	if iss, _ := claims["iss"].(string); iss != a.cfg.Issuer {
+		return fmt.Errorf("unexpected issuer %q", iss)
+	}
+	if !hasAudience(claims["aud"], a.cfg.Audience) {
+		return errors.New("unexpected audience")
+	}
+	return nil
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	// exp/nbfをfloat64にせず、大きな値でも正確に扱う
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func numericDate(v interface{}) (time.Time, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(f), 0), true
+}
+
+// hasAudience は、audが文字列と文字列の配列のどちらでも受け付ける
+func hasAudience(aud interface{}, want string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == want
+	case []interface{}:
+		for _, v := range aud {
+			if s, ok := v.(string); ok && s == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// jwk は、JWKSに含まれる1つの鍵
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	// oct
+	K string `json:"k"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// verificationKey は、JWKを検証に使える形にしたもの
+// 鍵の種類とアルゴリズムを結びつけておき、alg=noneや
+// 公開鍵をHMACの鍵として使わせるような攻撃を防ぐ
+type verificationKey struct {
+	kid    string
+	alg    string
+	verify func(signed, sig []byte) bool
+}
+
+func (k verificationKey) accepts(h jwtHeader) bool {
+	if h.Alg != k.alg {
+		return false
+	}
+	return h.Kid == "" || k.kid == "" || h.Kid == k.kid
+}
+
+func (j jwk) verificationKey() (verificationKey, error) {
+	b64 := base64.RawURLEncoding
+	key := verificationKey{kid: j.Kid}
+
+	switch j.Kty {
+	case "oct":
+		secret, err := b64.DecodeString(j.K)
+		if err != nil || len(secret) == 0 {
+			return key, errors.New("invalid oct key")
+		}
+		key.alg = "HS256"
+		key.verify = func(signed, sig []byte) bool {
+			mac := hmac.New(sha256.New, secret)
+			mac.Write(signed)
+			return hmac.Equal(sig, mac.Sum(nil))
+		}
+	case "RSA":
+		n, err := b64.DecodeString(j.N)
+		if err != nil {
+			return key, errors.New("invalid RSA key")
+		}
+		e, err := b64.DecodeString(j.E)
+		if err != nil {
+			return key, errors.New("invalid RSA key")
+		}
+		pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		if bits := pub.N.BitLen(); bits < minRSAKeyBits {
+			return key, fmt.Errorf("RSA key is %d bits, which is shorter than %d bits", bits, minRSAKeyBits)
+		}
+		key.alg = "RS256"
+		key.verify = func(signed, sig []byte) bool {
+			digest := sha256.Sum256(signed)
+			return rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig) == nil
+		}
+	case "EC":
+		if j.Crv != "P-256" {
+			return key, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := b64.DecodeString(j.X)
+		if err != nil {
+			return key, errors.New("invalid EC key")
+		}
+		y, err := b64.DecodeString(j.Y)
+		if err != nil {
+			return key, errors.New("invalid EC key")
+		}
+		// 曲線上にない点が指定された場合は、ecdsa.Verifyが常にfalseを返す
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		key.alg = "ES256"
+		key.verify = func(signed, sig []byte) bool {
+			// JWSのES256の署名は、ASN.1ではなくrとsを32バイトずつ並べたもの
+			if len(sig) != 64 {
+				return false
+			}
+			digest := sha256.Sum256(signed)
+			r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+			return ecdsa.Verify(pub, digest[:], r, s)
+		}
+	default:
+		return key, fmt.Errorf("unsupported key type %q", j.Kty)
+	}
+
+	if j.Alg != "" && j.Alg != key.alg {
+		return key, fmt.Errorf("alg %q does not match key type %q", j.Alg, j.Kty)
+	}
+	return key, nil
+}
+
+// keySet は、JWKSファイルから読んだ鍵を持ち、ファイルが変わったら読み直す
+type keySet struct {
+	path string
+
+	mu      sync.RWMutex
+	keys    []verificationKey
+	modTime time.Time
+	size    int64
+}
+
+func (s *keySet) get() ([]verificationKey, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		// ファイルが一時的に見えなくなっても、読み込み済みの鍵で検証を続ける
+		log.Println("failed to stat JWKS file:", err)
+	} else if s.changed(info) {
+		if err := s.load(); err != nil {
+			log.Println("failed to reload JWKS file:", err)
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.keys, nil
+}
+
+func (s *keySet) changed(info os.FileInfo) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return !info.ModTime().Equal(s.modTime) || info.Size() != s.size
+}
+
+// load は、JWKSファイルを読み直す
+// 1つでも読めない鍵があれば、書きかけのファイルとみなして今の鍵を残す
+func (s *keySet) load() error {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(f).Decode(&jwks); err != nil {
+		return fmt.Errorf("%s: %w", s.path, err)
+	}
+	keys := make([]verificationKey, 0, len(jwks.Keys))
+	for i, j := range jwks.Keys {
+		key, err := j.verificationKey()
+		if err != nil {
+			return fmt.Errorf("%s: keys[%d]: %w", s.path, i, err)
+		}
+		keys = append(keys, key)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+	s.modTime = info.ModTime()
+	s.size = info.Size()
+	return nil
+}
+
+// looksLikeJWT は、トークンがJWSのコンパクト形式かどうかを返す
+func looksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+type combined struct {
+	tokens Authenticator
+	jwt    Authenticator
+}
+
+// Combine は、JWTの形をしたトークンはjwtで、それ以外はtokensで認証する
+// 個人用アクセストークンとIdPのJWTを併用する場合に使う
+func Combine(tokens, jwt Authenticator) Authenticator {
+	return &combined{tokens: tokens, jwt: jwt}
+}
+
+func (c *combined) AuthenticateToken(ctx context.Context, token string) (*model.User, scope.Set, error) {
+	if looksLikeJWT(token) {
+		return c.jwt.AuthenticateToken(ctx, token)
+	}
+	return c.tokens.AuthenticateToken(ctx, token)
+}
@@ -0,0 +1,333 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var b64 = base64.RawURLEncoding
+
+// users は、nameがキーのユーザーだけを返すUserFinder
+type users map[string]*model.User
+
+func (u users) GetUserByName(ctx context.Context, name string) (*model.User, error) {
+	if user, ok := u[name]; ok {
+		return user, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+var testUsers = users{"hsaki": {ID: "U_1", Name: "hsaki"}}
+
+// signer は、テスト用の鍵でJWTに署名する
+type signer struct {
+	alg  string
+	kid  string
+	jwk  map[string]string
+	sign func(signed []byte) []byte
+}
+
+func hs256Signer(t *testing.T, kid string) signer {
+	t.Helper()
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatal(err)
+	}
+	return signer{
+		alg: "HS256",
+		kid: kid,
+		jwk: map[string]string{"kty": "oct", "kid": kid, "k": b64.EncodeToString(secret)},
+		sign: func(signed []byte) []byte {
+			mac := hmac.New(sha256.New, secret)
+			mac.Write(signed)
+			return mac.Sum(nil)
+		},
+	}
+}
+
+func rs256Signer(t *testing.T, kid string) signer {
+	t.Helper()
+	return rsaSigner(t, kid, 2048)
+}
+
+func rsaSigner(t *testing.T, kid string, bits int) signer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer{
+		alg: "RS256",
+		kid: kid,
+		jwk: map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"n":   b64.EncodeToString(key.N.Bytes()),
+			"e":   b64.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		},
+		sign: func(signed []byte) []byte {
+			digest := sha256.Sum256(signed)
+			sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			return sig
+		},
+	}
+}
+
+func es256Signer(t *testing.T, kid string) signer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer{
+		alg: "ES256",
+		kid: kid,
+		jwk: map[string]string{
+			"kty": "EC",
+			"kid": kid,
+			"crv": "P-256",
+			"x":   b64.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   b64.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		},
+		sign: func(signed []byte) []byte {
+			digest := sha256.Sum256(signed)
+			r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			sig := make([]byte, 64)
+			r.FillBytes(sig[:32])
+			s.FillBytes(sig[32:])
+			return sig
+		},
+	}
+}
+
+func (s signer) token(t *testing.T, claims map[string]interface{}) string {
+	t.Helper()
+	return s.tokenWithHeader(t, map[string]string{"alg": s.alg, "kid": s.kid, "typ": "JWT"}, claims)
+}
+
+func (s signer) tokenWithHeader(t *testing.T, header map[string]string, claims map[string]interface{}) string {
+	t.Helper()
+	h, err := json.Marshal(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := b64.EncodeToString(h) + "." + b64.EncodeToString(c)
+	return signed + "." + b64.EncodeToString(s.sign([]byte(signed)))
+}
+
+func writeJWKS(t *testing.T, path string, signers ...signer) {
+	t.Helper()
+	var jwks struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	for _, s := range signers {
+		jwks.Keys = append(jwks.Keys, s.jwk)
+	}
+	b, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func validClaims() map[string]interface{} {
+	now := time.Now()
+	return map[string]interface{}{
+		"sub":   "hsaki",
+		"iss":   "https://idp.example.com",
+		"aud":   []string{"graphql-sample", "other"},
+		"exp":   now.Add(time.Hour).Unix(),
+		"nbf":   now.Add(-time.Minute).Unix(),
+		"scope": "repo:read project openid",
+	}
+}
+
+func newJWTAuthenticator(t *testing.T, signers ...signer) (*auth.JWTAuthenticator, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, signers...)
+	a, err := auth.NewJWTAuthenticator(auth.JWTConfig{
+		JWKSFile: path,
+		Issuer:   "https://idp.example.com",
+		Audience: "graphql-sample",
+	}, testUsers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a, path
+}
+
+func TestJWTAuthenticatorAlgorithms(t *testing.T) {
+	signers := []signer{hs256Signer(t, "hs"), rs256Signer(t, "rs"), es256Signer(t, "es")}
+	a, _ := newJWTAuthenticator(t, signers...)
+
+	for _, s := range signers {
+		t.Run(s.alg, func(t *testing.T) {
+			user, scopes, err := a.AuthenticateToken(context.Background(), s.token(t, validClaims()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if user.ID != "U_1" {
+				t.Errorf("unexpected user: %+v", user)
+			}
+			// このサービスのものではないスコープは無視する
+			if diff := cmp.Diff(scope.Set{scope.RepoRead, scope.Project}, scopes); diff != "" {
+				t.Errorf("scopes mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJWTAuthenticatorRejects(t *testing.T) {
+	hs := hs256Signer(t, "hs")
+	rs := rs256Signer(t, "rs")
+	a, _ := newJWTAuthenticator(t, hs, rs)
+
+	with := func(key string, value interface{}) map[string]interface{} {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "expired", token: hs.token(t, with("exp", now.Add(-time.Hour).Unix()))},
+		{name: "without exp", token: hs.token(t, with("exp", nil))},
+		{name: "not valid yet", token: hs.token(t, with("nbf", now.Add(time.Hour).Unix()))},
+		{name: "other audience", token: hs.token(t, with("aud", "other"))},
+		{name: "without aud", token: hs.token(t, with("aud", nil))},
+		{name: "other issuer", token: hs.token(t, with("iss", "https://evil.example.com"))},
+		{name: "without iss", token: hs.token(t, with("iss", nil))},
+		{name: "unknown user", token: hs.token(t, with("sub", "nobody"))},
+		{name: "unknown key", token: hs256Signer(t, "hs").token(t, validClaims())},
+		{name: "alg none", token: hs.tokenWithHeader(t, map[string]string{"alg": "none"}, validClaims())},
+		// RSAの公開鍵をHMACの鍵として使わせる攻撃
+		{name: "algorithm confusion", token: hs.tokenWithHeader(t, map[string]string{"alg": "HS256", "kid": "rs"}, validClaims())},
+		{name: "malformed", token: "a.b.c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := a.AuthenticateToken(context.Background(), tt.token)
+			if apperrors.From(err).Code != apperrors.Unauthenticated {
+				t.Errorf("want unauthenticated, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewJWTAuthenticatorRejectsConfig(t *testing.T) {
+	dir := t.TempDir()
+	validKeys := filepath.Join(dir, "valid.json")
+	writeJWKS(t, validKeys, es256Signer(t, "es"))
+	weakKeys := filepath.Join(dir, "weak.json")
+	writeJWKS(t, weakKeys, rsaSigner(t, "weak", 1024))
+
+	tests := []struct {
+		name string
+		cfg  auth.JWTConfig
+	}{
+		{name: "without issuer", cfg: auth.JWTConfig{JWKSFile: validKeys, Audience: "graphql-sample"}},
+		{name: "without audience", cfg: auth.JWTConfig{JWKSFile: validKeys, Issuer: "https://idp.example.com"}},
+		{name: "short RSA key", cfg: auth.JWTConfig{JWKSFile: weakKeys, Issuer: "https://idp.example.com", Audience: "graphql-sample"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := auth.NewJWTAuthenticator(tt.cfg, testUsers); err == nil {
+				t.Error("want error, but got nil")
+			}
+		})
+	}
+}
+
+func TestJWTAuthenticatorReloadsKeys(t *testing.T) {
+	oldKey := es256Signer(t, "old")
+	a, path := newJWTAuthenticator(t, oldKey)
+	if _, _, err := a.AuthenticateToken(context.Background(), oldKey.token(t, validClaims())); err != nil {
+		t.Fatal(err)
+	}
+
+	// 鍵をローテーションする
+	newKey := es256Signer(t, "new")
+	writeJWKS(t, path, newKey)
+	later := time.Now().Add(time.Second)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := a.AuthenticateToken(context.Background(), newKey.token(t, validClaims())); err != nil {
+		t.Errorf("token signed with the new key is rejected: %v", err)
+	}
+	if _, _, err := a.AuthenticateToken(context.Background(), oldKey.token(t, validClaims())); err == nil {
+		t.Error("token signed with the removed key is accepted")
+	}
+
+	// 壊れたファイルに書き換えられても、読み込み済みの鍵で検証を続ける
+	if err := os.WriteFile(path, []byte(`{"keys": [`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := a.AuthenticateToken(context.Background(), newKey.token(t, validClaims())); err != nil {
+		t.Errorf("token is rejected after a broken reload: %v", err)
+	}
+}
+
+func TestCombine(t *testing.T) {
+	hs := hs256Signer(t, "hs")
+	jwt, _ := newJWTAuthenticator(t, hs)
+	a := auth.Combine(tokens{"pat_hsaki": {ID: "U_1", Name: "hsaki"}}, jwt)
+
+	for _, token := range []string{"pat_hsaki", hs.token(t, validClaims())} {
+		if user, _, err := a.AuthenticateToken(context.Background(), token); err != nil || user.ID != "U_1" {
+			t.Errorf("token %q is not authenticated: %v", token, err)
+		}
+	}
+}
+
+// tokens は、個人用アクセストークンの代わりに使うAuthenticator
+type tokens map[string]*model.User
+
+func (m tokens) AuthenticateToken(ctx context.Context, token string) (*model.User, scope.Set, error) {
+	if user, ok := m[token]; ok {
+		return user, scope.All, nil
+	}
+	return nil, nil, apperrors.New(apperrors.Unauthenticated, "invalid token")
+}
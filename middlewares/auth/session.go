@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// SessionCookieName は、ログインセッションのトークンを入れるCookieの名前
+	SessionCookieName = "session"
+	// CSRFHeader は、Cookieで認証するPOSTに付けるCSRFトークンのヘッダ
+	CSRFHeader = "X-CSRF-Token"
+)
+
+// Session は、ログインで発行したセッション
+type Session struct {
+	// Token は、Cookieに入れるトークン。DBにはハッシュだけを保存する
+	Token string
+	// CSRFToken は、Cookieで認証するPOSTでCSRFHeaderに入れてもらうトークン
+	CSRFToken string
+	ExpiresAt time.Time
+}
+
+// SessionAuthenticator は、Cookieで送られたセッションのユーザーとセッションを返す
+// 存在しない・期限切れのセッションにはapperrors.Unauthenticatedのエラーを返す
+type SessionAuthenticator interface {
+	AuthenticateSession(ctx context.Context, token string) (*model.User, *Session, error)
+}
+
+// scopesOfSession は、セッションに与えるスコープ
+// ログインしたユーザー本人の操作なので、トークンのように絞り込まない
+var scopesOfSession = scope.All
+
+type options struct {
+	sessions     SessionAuthenticator
+	impersonator Impersonator
+}
+
+type Option func(*options)
+
+// WithSessions は、Authorizationヘッダがない場合にセッションのCookieでも認証する
+func WithSessions(sessions SessionAuthenticator) Option {
+	return func(o *options) {
+		o.sessions = sessions
+	}
+}
+
+type sessionKey struct{}
+
+type cookieWriterKey struct{}
+
+// cookieWriter は、resolverからレスポンスにCookieを書き込むためのもの
+type cookieWriter struct {
+	w      http.ResponseWriter
+	secure bool
+}
+
+func withCookieWriter(ctx context.Context, w http.ResponseWriter, req *http.Request) context.Context {
+	return context.WithValue(ctx, cookieWriterKey{}, &cookieWriter{w: w, secure: isSecure(req)})
+}
+
+// isSecure は、HTTPSで受けたリクエストかを返す。Secure属性のCookieはHTTPSでしか送られない
+func isSecure(req *http.Request) bool {
+	return req.TLS != nil || req.Header.Get("X-Forwarded-Proto") == "https"
+}
+
+// GetSession は、Cookieで認証された場合のセッションを返す
+func GetSession(ctx context.Context) (*Session, bool) {
+	session, ok := ctx.Value(sessionKey{}).(*Session)
+	return session, ok && session != nil
+}
+
+// SetSessionCookie は、レスポンスにセッションのCookieを付ける
+// AuthMiddlewareを通っていないリクエストでは何もしない
+func SetSessionCookie(ctx context.Context, session *Session) {
+	cw, ok := ctx.Value(cookieWriterKey{}).(*cookieWriter)
+	if !ok {
+		return
+	}
+	http.SetCookie(cw.w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    session.Token,
+		Path:     "/",
+		Expires:  session.ExpiresAt,
+		HttpOnly: true,
+		Secure:   cw.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// ClearSessionCookie は、ブラウザにセッションのCookieを消させる
+func ClearSessionCookie(ctx context.Context) {
+	cw, ok := ctx.Value(cookieWriterKey{}).(*cookieWriter)
+	if !ok {
+		return
+	}
+	clearSessionCookie(cw.w, cw.secure)
+}
+
+func clearSessionCookie(w http.ResponseWriter, secure bool) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// authenticateSession は、セッションのCookieで認証したctxを返す
+// 無効なCookieは消して未認証として扱い、CSRFの検査に失敗した場合はfalseを返す
+func authenticateSession(w http.ResponseWriter, req *http.Request, sessions SessionAuthenticator) (context.Context, bool) {
+	ctx := req.Context()
+	cookie, err := req.Cookie(SessionCookieName)
+	if err != nil || cookie.Value == "" {
+		return ctx, true
+	}
+
+	user, session, err := sessions.AuthenticateSession(ctx, cookie.Value)
+	if err != nil {
+		if apperrors.From(err).Code != apperrors.Unauthenticated {
+			log.Println("failed to authenticate session:", err)
+		}
+		clearSessionCookie(w, isSecure(req))
+		return ctx, true
+	}
+
+	if req.Method == http.MethodPost && !validCSRF(req, session) {
+		writeError(w, http.StatusForbidden, apperrors.Forbidden, "CSRF token mismatch")
+		return nil, false
+	}
+	ctx = context.WithValue(ctx, sessionKey{}, session)
+	return WithUser(ctx, user, scopesOfSession), true
+}
+
+// validCSRF は、CookieはブラウザがどのサイトからのPOSTにも付けてしまうので、
+// セッションのCSRFトークンを知っているか、同じオリジンからのリクエストであることを確かめる
+func validCSRF(req *http.Request, session *Session) bool {
+	if token := req.Header.Get(CSRFHeader); token != "" {
+		return subtle.ConstantTimeCompare([]byte(token), []byte(session.CSRFToken)) == 1
+	}
+	if req.Header.Get("Sec-Fetch-Site") == "same-origin" {
+		return true
+	}
+	origin, err := url.Parse(req.Header.Get("Origin"))
+	return err == nil && origin.Host != "" && origin.Host == req.Host
+}
+
+// writeError は、GraphQLのレスポンスと同じ形でエラーを返す
+func writeError(w http.ResponseWriter, status int, code apperrors.Code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(&graphql.Response{
+		Errors: gqlerror.List{{
+			Message: message,
+			Extensions: map[string]interface{}{
+				"code": code,
+			},
+		}},
+	})
+}
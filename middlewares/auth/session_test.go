@@ -0,0 +1,119 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
+	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+)
+
+// sessions は、tokenがキーのセッションだけを受け付けるSessionAuthenticator
+type sessions map[string]*auth.Session
+
+func (s sessions) AuthenticateSession(ctx context.Context, token string) (*model.User, *auth.Session, error) {
+	if session, ok := s[token]; ok {
+		return &model.User{ID: "U_1", Name: "hsaki"}, session, nil
+	}
+	return nil, nil, apperrors.New(apperrors.Unauthenticated, "invalid session")
+}
+
+func TestSessionMiddleware(t *testing.T) {
+	valid := sessions{"session-token": {Token: "session-token", CSRFToken: "csrf-token"}}
+
+	tests := []struct {
+		name       string
+		method     string
+		cookie     string
+		header     map[string]string
+		wantStatus int
+		wantUser   bool
+		wantClear  bool
+	}{
+		{name: "no cookie", method: http.MethodPost, wantStatus: http.StatusOK},
+		{name: "GET with cookie", method: http.MethodGet, cookie: "session-token", wantStatus: http.StatusOK, wantUser: true},
+		{name: "POST without CSRF token", method: http.MethodPost, cookie: "session-token", wantStatus: http.StatusForbidden},
+		{
+			name: "POST with wrong CSRF token", method: http.MethodPost, cookie: "session-token",
+			header:     map[string]string{auth.CSRFHeader: "wrong"},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "POST with CSRF token", method: http.MethodPost, cookie: "session-token",
+			header:     map[string]string{auth.CSRFHeader: "csrf-token"},
+			wantStatus: http.StatusOK, wantUser: true,
+		},
+		{
+			name: "POST from same origin", method: http.MethodPost, cookie: "session-token",
+			header:     map[string]string{"Origin": "http://example.com"},
+			wantStatus: http.StatusOK, wantUser: true,
+		},
+		{
+			name: "POST from other origin", method: http.MethodPost, cookie: "session-token",
+			header:     map[string]string{"Origin": "http://evil.example"},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "POST from same site fetch", method: http.MethodPost, cookie: "session-token",
+			header:     map[string]string{"Sec-Fetch-Site": "same-origin"},
+			wantStatus: http.StatusOK, wantUser: true,
+		},
+		{name: "invalid cookie", method: http.MethodPost, cookie: "expired", wantStatus: http.StatusOK, wantClear: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUser bool
+			next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				_, gotUser = auth.GetUser(req.Context())
+			})
+			handler := auth.AuthMiddleware(nil, next, auth.WithSessions(valid))
+
+			req := httptest.NewRequest(tt.method, "http://example.com/query", nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: auth.SessionCookieName, Value: tt.cookie})
+			}
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("want status %d, but got %d", tt.wantStatus, rec.Code)
+			}
+			if gotUser != tt.wantUser {
+				t.Errorf("want authenticated=%v, but got %v", tt.wantUser, gotUser)
+			}
+			cleared := false
+			for _, c := range rec.Result().Cookies() {
+				if c.Name == auth.SessionCookieName && c.MaxAge < 0 {
+					cleared = true
+				}
+			}
+			if cleared != tt.wantClear {
+				t.Errorf("want cookie cleared=%v, but got %v", tt.wantClear, cleared)
+			}
+		})
+	}
+}
+
+func TestSetSessionCookie(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		auth.SetSessionCookie(req.Context(), &auth.Session{Token: "session-token"})
+	})
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/query", nil)
+	rec := httptest.NewRecorder()
+	auth.AuthMiddleware(nil, next).ServeHTTP(rec, req)
+
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("want 1 cookie, but got %d", len(cookies))
+	}
+	c := cookies[0]
+	if c.Name != auth.SessionCookieName || c.Value != "session-token" || !c.HttpOnly || !c.Secure || c.SameSite != http.SameSiteLaxMode {
+		t.Errorf("unexpected cookie: %+v", c)
+	}
+}
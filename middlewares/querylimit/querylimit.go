@@ -0,0 +1,226 @@
+// Package querylimit は、コストの計算より手前で、極端に大きいリクエストやドキュメントを拒否する
+// 0を指定した制限は無効になる
+package querylimit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/lexer"
+)
+
+type Limits struct {
+	// フィールドの入れ子の深さ
+	MaxDepth int
+	// ドキュメント全体に含まれるエイリアスの数
+	MaxAliases int
+	// オペレーションの最上位のフィールドの数
+	MaxRootFields int
+	// ドキュメントのトークン数(コメントを除く)
+	MaxTokens int
+	// リクエストボディのバイト数
+	MaxBodyBytes int64
+}
+
+var DefaultLimits = Limits{
+	MaxDepth:      15,
+	MaxAliases:    30,
+	MaxRootFields: 20,
+	MaxTokens:     5000,
+	MaxBodyBytes:  100 * 1024,
+}
+
+const (
+	codeMaxDepthExceeded      = "MAX_DEPTH_EXCEEDED"
+	codeMaxAliasesExceeded    = "MAX_ALIASES_EXCEEDED"
+	codeMaxRootFieldsExceeded = "MAX_ROOT_FIELDS_EXCEEDED"
+	codeMaxTokensExceeded     = "MAX_TOKENS_EXCEEDED"
+)
+
+type Extension struct {
+	limits Limits
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+	graphql.OperationContextMutator
+} = &Extension{}
+
+func New(limits Limits) *Extension {
+	return &Extension{limits: limits}
+}
+
+func (e *Extension) ExtensionName() string {
+	return "QueryLimit"
+}
+
+func (e *Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters は、パースする前にトークン数を数える
+// 巨大なドキュメントのパースやバリデーションにCPUを使わせないため
+// APQなどハッシュやIDからクエリを展開するExtensionより後に登録しないと、展開後のクエリを数えられない
+func (e *Extension) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	if e.limits.MaxTokens <= 0 {
+		return nil
+	}
+
+	lex := lexer.New(&ast.Source{Input: rawParams.Query})
+	tokens := 0
+	for {
+		tok, err := lex.ReadToken()
+		if err != nil {
+			// 構文エラーはパーサーに任せる
+			return nil
+		}
+		if tok.Kind == lexer.EOF {
+			return nil
+		}
+		if tok.Kind == lexer.Comment {
+			continue
+		}
+		tokens++
+		if tokens > e.limits.MaxTokens {
+			return limitError(codeMaxTokensExceeded, "query exceeds the maximum of %d tokens", e.limits.MaxTokens)
+		}
+	}
+}
+
+// MutateOperationContext は、バリデーション済みのドキュメントの形を検査する
+func (e *Extension) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if e.limits.MaxAliases > 0 {
+		if aliases := countAliases(opCtx.Doc); aliases > e.limits.MaxAliases {
+			return limitError(codeMaxAliasesExceeded, "query has %d aliases, which exceeds the maximum of %d", aliases, e.limits.MaxAliases)
+		}
+	}
+	if opCtx.Operation == nil {
+		return nil
+	}
+	if e.limits.MaxRootFields > 0 {
+		if fields := countFields(opCtx.Operation.SelectionSet); fields > e.limits.MaxRootFields {
+			return limitError(codeMaxRootFieldsExceeded, "query has %d root fields, which exceeds the maximum of %d", fields, e.limits.MaxRootFields)
+		}
+	}
+	if e.limits.MaxDepth > 0 {
+		d := &depthCounter{fragments: make(map[string]int)}
+		if depth := d.depth(opCtx.Operation.SelectionSet); depth > e.limits.MaxDepth {
+			return limitError(codeMaxDepthExceeded, "query has depth %d, which exceeds the maximum of %d", depth, e.limits.MaxDepth)
+		}
+	}
+	return nil
+}
+
+// countAliases は、フラグメントの定義も含めてドキュメント中のエイリアスを数える
+// フラグメントを展開して数えると、展開の回数だけ計算量が増えるので展開はしない
+func countAliases(doc *ast.QueryDocument) int {
+	cnt := 0
+	var walk func(ast.SelectionSet)
+	walk = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				if sel.Alias != sel.Name {
+					cnt++
+				}
+				walk(sel.SelectionSet)
+			case *ast.InlineFragment:
+				walk(sel.SelectionSet)
+			}
+		}
+	}
+	for _, op := range doc.Operations {
+		walk(op.SelectionSet)
+	}
+	for _, f := range doc.Fragments {
+		walk(f.SelectionSet)
+	}
+	return cnt
+}
+
+// countFields は、フラグメントを展開したうえで、その階層にあるフィールドの数を数える
+func countFields(set ast.SelectionSet) int {
+	cnt := 0
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			cnt++
+		case *ast.InlineFragment:
+			cnt += countFields(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				cnt += countFields(sel.Definition.SelectionSet)
+			}
+		}
+	}
+	return cnt
+}
+
+// depthCounter は、フラグメントごとの深さを覚えておき、同じフラグメントを何度も辿らないようにする
+type depthCounter struct {
+	fragments map[string]int
+}
+
+func (d *depthCounter) depth(set ast.SelectionSet) int {
+	max := 0
+	for _, sel := range set {
+		var depth int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			depth = 1 + d.depth(sel.SelectionSet)
+		case *ast.InlineFragment:
+			depth = d.depth(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			depth = d.fragmentDepth(sel)
+		}
+		if depth > max {
+			max = depth
+		}
+	}
+	return max
+}
+
+func (d *depthCounter) fragmentDepth(spread *ast.FragmentSpread) int {
+	if spread.Definition == nil {
+		return 0
+	}
+	if depth, ok := d.fragments[spread.Name]; ok {
+		return depth
+	}
+	// 循環はバリデーションで弾かれているが、念のため辿っている間は0とみなす
+	d.fragments[spread.Name] = 0
+	depth := d.depth(spread.Definition.SelectionSet)
+	d.fragments[spread.Name] = depth
+	return depth
+}
+
+func limitError(code, format string, args ...interface{}) *gqlerror.Error {
+	err := gqlerror.Errorf(format, args...)
+	err.Extensions = map[string]interface{}{
+		"code": code,
+	}
+	return err
+}
+
+// BodyLimit は、MaxBodyBytesを超えるリクエストボディを読み込む前に拒否する
+func (e *Extension) BodyLimit(next http.Handler) http.Handler {
+	if e.limits.MaxBodyBytes <= 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.ContentLength > e.limits.MaxBodyBytes {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			fmt.Fprintf(w, `{"errors":[{"message":"request body exceeds the maximum of %d bytes"}]}`, e.limits.MaxBodyBytes)
+			return
+		}
+		// Content-Lengthのないリクエストも、読み込める量を制限しておく
+		req.Body = http.MaxBytesReader(w, req.Body, e.limits.MaxBodyBytes)
+		next.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,242 @@
+package querylimit_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/saki-engineering/graphql-sample/middlewares/querylimit"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var schema = gqlparser.MustLoadSchema(&ast.Source{Input: `
+	type Query {
+		name: String!
+		node: Node
+	}
+	type Node {
+		id: ID!
+		child: Node
+	}
+`})
+
+var testLimits = querylimit.Limits{
+	MaxDepth:      5,
+	MaxAliases:    3,
+	MaxRootFields: 3,
+	MaxTokens:     50,
+	MaxBodyBytes:  4096,
+}
+
+// newServer は、APQの後にlimitsのExtensionを登録したサーバーを作る
+// 実行されたオペレーションの数をexecutedで返す
+func newServer(t *testing.T, limits querylimit.Limits) (*httptest.Server, graphql.MapCache[string], *int) {
+	t.Helper()
+	executed := 0
+	h := handler.New(&graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(typeName, fieldName string, childComplexity int, args map[string]any) (int, bool) {
+			return 0, false
+		},
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			executed++
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{}`)})
+		},
+	})
+	h.AddTransport(transport.POST{})
+	cache := graphql.MapCache[string]{}
+	h.Use(extension.AutomaticPersistedQuery{Cache: cache})
+	ext := querylimit.New(limits)
+	h.Use(ext)
+
+	srv := httptest.NewServer(ext.BodyLimit(h))
+	t.Cleanup(srv.Close)
+	return srv, cache, &executed
+}
+
+type request struct {
+	Query      string                 `json:"query,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+type response struct {
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func post(t *testing.T, srv *httptest.Server, body request) (int, response) {
+	t.Helper()
+	b, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.Post(srv.URL, "application/json", bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	var r response
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, r
+}
+
+// errorCode は、最初のエラーのコードを返す。エラーがない場合は空文字
+func errorCode(r response) string {
+	if len(r.Errors) == 0 {
+		return ""
+	}
+	code, _ := r.Errors[0].Extensions["code"].(string)
+	return code
+}
+
+func repeat(format string, n int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, format, i)
+	}
+	return b.String()
+}
+
+// nested は、nodeの下にchildをn段入れ子にしたクエリを返す
+func nested(n int) string {
+	return "{ node { " + strings.Repeat("child { ", n) + "id" + strings.Repeat(" }", n) + " } }"
+}
+
+func TestLimits(t *testing.T) {
+	tests := []struct {
+		name string
+		// nilの場合はtestLimitsを使う
+		limits *querylimit.Limits
+		query  string
+		want   string
+	}{
+		{
+			name:  "within limits",
+			query: `{ node { child { id } } }`,
+		},
+		{
+			name:  "depth at the limit",
+			query: nested(3),
+		},
+		{
+			name:  "deep nesting",
+			query: nested(4),
+			want:  "MAX_DEPTH_EXCEEDED",
+		},
+		{
+			name: "deep nesting through fragments",
+			query: `{ node { ...A } }
+				fragment A on Node { child { ...B } }
+				fragment B on Node { child { ...C } }
+				fragment C on Node { child { child { id } } }`,
+			want: "MAX_DEPTH_EXCEEDED",
+		},
+		{
+			name:  "many aliases",
+			query: "{ node { " + repeat("a%d: id ", 4) + "} }",
+			want:  "MAX_ALIASES_EXCEEDED",
+		},
+		{
+			// フラグメントの中のエイリアスも数える
+			name:  "many aliases in a fragment",
+			query: "{ node { ...F } } fragment F on Node { " + repeat("a%d: id ", 4) + "}",
+			want:  "MAX_ALIASES_EXCEEDED",
+		},
+		{
+			// 同じフィールドを並べてもまとめて1つとは数えない
+			name:  "many root fields",
+			query: "{ " + strings.Repeat("name ", 4) + "}",
+			want:  "MAX_ROOT_FIELDS_EXCEEDED",
+		},
+		{
+			name:  "many tokens",
+			query: "{ node { " + strings.Repeat("id ", 50) + "} }",
+			want:  "MAX_TOKENS_EXCEEDED",
+		},
+		{
+			// コメントはトークンに数えない
+			name:  "long comments",
+			query: "{ " + strings.Repeat("# comment\n", 100) + "name }",
+		},
+		{
+			// 構文エラーはパーサーが返す
+			name:  "syntax error",
+			query: "{ node { id",
+			want:  "GRAPHQL_PARSE_FAILED",
+		},
+		{
+			name:   "disabled limits",
+			limits: &querylimit.Limits{},
+			query:  nested(10),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits := testLimits
+			if tt.limits != nil {
+				limits = *tt.limits
+			}
+			srv, _, executed := newServer(t, limits)
+
+			_, res := post(t, srv, request{Query: tt.query})
+			if got := errorCode(res); got != tt.want {
+				t.Errorf("want code %q, but got %q: %+v", tt.want, got, res.Errors)
+			}
+			if tt.want != "" && *executed != 0 {
+				t.Error("rejected query must not be executed")
+			}
+		})
+	}
+}
+
+func TestLimitsPersistedQuery(t *testing.T) {
+	srv, cache, executed := newServer(t, testLimits)
+
+	// ハッシュだけのリクエストも、APQで展開したクエリのトークンを数える
+	query := "{ node { " + strings.Repeat("id ", 50) + "} }"
+	sum := sha256.Sum256([]byte(query))
+	hash := hex.EncodeToString(sum[:])
+	cache.Add(context.Background(), hash, query)
+
+	_, res := post(t, srv, request{Extensions: map[string]interface{}{
+		"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": hash},
+	}})
+	if got := errorCode(res); got != "MAX_TOKENS_EXCEEDED" {
+		t.Errorf("want MAX_TOKENS_EXCEEDED, but got %q: %+v", got, res.Errors)
+	}
+	if *executed != 0 {
+		t.Error("rejected query must not be executed")
+	}
+}
+
+func TestBodyLimit(t *testing.T) {
+	srv, _, executed := newServer(t, testLimits)
+
+	status, res := post(t, srv, request{Query: "{ name }" + strings.Repeat(" ", 5000)})
+	if status != http.StatusRequestEntityTooLarge {
+		t.Errorf("want status 413, but got %d", status)
+	}
+	if len(res.Errors) == 0 {
+		t.Error("want error, but got none")
+	}
+	if *executed != 0 {
+		t.Error("rejected query must not be executed")
+	}
+}
@@ -0,0 +1,242 @@
+// Package ratelimit は、オペレーションのコストを1時間ごとの予算から差し引くレート制限を提供する
+// 認証済みのユーザーはユーザーごと、未認証の場合はクライアントのIPごとに予算を持つ
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	DefaultUserLimit      = 5000
+	DefaultAnonymousLimit = 500
+	DefaultWindow         = time.Hour
+
+	extensionName   = "RateLimit"
+	codeRateLimited = "RATE_LIMITED"
+)
+
+// Status は、あるオペレーションを実行した時点での予算の状況
+type Status struct {
+	// このオペレーションのコスト
+	Cost      int
+	Limit     int
+	Remaining int
+	ResetAt   time.Time
+}
+
+type bucket struct {
+	used    int
+	resetAt time.Time
+}
+
+type Limiter struct {
+	userLimit      int
+	anonymousLimit int
+	window         time.Duration
+	now            func() time.Time
+	es             graphql.ExecutableSchema
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &Limiter{}
+
+func New(userLimit, anonymousLimit int, window time.Duration) *Limiter {
+	return &Limiter{
+		userLimit:      userLimit,
+		anonymousLimit: anonymousLimit,
+		window:         window,
+		now:            time.Now,
+		buckets:        make(map[string]*bucket),
+	}
+}
+
+func (l *Limiter) ExtensionName() string {
+	return extensionName
+}
+
+func (l *Limiter) Validate(schema graphql.ExecutableSchema) error {
+	l.es = schema
+	return nil
+}
+
+// MutateOperationContext は、オペレーションのコストを予算から差し引く
+// 予算が足りない場合は実行せずにエラーを返し、予算も消費しない
+func (l *Limiter) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	op := opCtx.Doc.Operations.ForName(opCtx.OperationName)
+	cost := complexity.Calculate(l.es, op, opCtx.Variables)
+
+	key, limit := l.budgetFor(ctx)
+	status, ok := l.charge(key, limit, cost)
+	opCtx.Stats.SetExtension(extensionName, status)
+	if p, found := ctx.Value(statusKey{}).(*atomic.Pointer[Status]); found {
+		p.Store(status)
+	}
+
+	if !ok {
+		err := gqlerror.Errorf("API rate limit exceeded: operation costs %d points but only %d remain until %s",
+			cost, status.Remaining, status.ResetAt.Format(time.RFC3339))
+		err.Extensions = map[string]interface{}{
+			"code": codeRateLimited,
+		}
+		return err
+	}
+	return nil
+}
+
+func (l *Limiter) budgetFor(ctx context.Context) (string, int) {
+	// なりすましている場合は、なりすまされたユーザーではなく管理者の予算を使う
+	if admin, ok := auth.GetImpersonator(ctx); ok {
+		return "user:" + admin.Name, l.userLimit
+	}
+	if userName, ok := auth.GetUserName(ctx); ok {
+		return "user:" + userName, l.userLimit
+	}
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return "ip:" + ip, l.anonymousLimit
+}
+
+// charge は、keyの予算からcostを差し引く
+// 負のコストで予算を増やせないよう、0未満のコストは0として扱う
+func (l *Limiter) charge(key string, limit, cost int) (*Status, bool) {
+	if cost < 0 {
+		cost = 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok || !now.Before(b.resetAt) {
+		b = &bucket{resetAt: now.Add(l.window)}
+		l.buckets[key] = b
+	}
+
+	allowed := b.used+cost <= limit
+	if allowed {
+		b.used += cost
+	}
+	return &Status{
+		Cost:      cost,
+		Limit:     limit,
+		Remaining: limit - b.used,
+		ResetAt:   b.resetAt,
+	}, allowed
+}
+
+// sweep は、期限の切れた予算を捨ててメモリが増え続けないようにする
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.window {
+		return
+	}
+	for key, b := range l.buckets {
+		if !now.Before(b.resetAt) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// GetStatus は、実行中のオペレーションの予算の状況を返す
+func GetStatus(ctx context.Context) *Status {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+	status, _ := graphql.GetOperationContext(ctx).Stats.GetExtension(extensionName).(*Status)
+	return status
+}
+
+type clientIPKey struct{}
+type statusKey struct{}
+
+// Middleware は、クライアントのIPをcontextに入れ、予算の状況をX-RateLimit-*ヘッダで返す
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ip, _, err := net.SplitHostPort(req.RemoteAddr)
+		if err != nil {
+			ip = req.RemoteAddr
+		}
+
+		rw := &headerWriter{ResponseWriter: w}
+		ctx := context.WithValue(req.Context(), clientIPKey{}, ip)
+		ctx = context.WithValue(ctx, statusKey{}, &rw.status)
+		next.ServeHTTP(rw, req.WithContext(ctx))
+	})
+}
+
+// headerWriter は、レスポンスを書き始める直前にX-RateLimit-*ヘッダを付ける
+type headerWriter struct {
+	http.ResponseWriter
+	status      atomic.Pointer[Status]
+	wroteHeader bool
+}
+
+func (w *headerWriter) setHeaders() {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	status := w.status.Load()
+	if status == nil {
+		return
+	}
+	h := w.Header()
+	h.Set("X-RateLimit-Limit", strconv.Itoa(status.Limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(status.Remaining))
+	h.Set("X-RateLimit-Used", strconv.Itoa(status.Limit-status.Remaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(status.ResetAt.Unix(), 10))
+}
+
+func (w *headerWriter) WriteHeader(code int) {
+	w.setHeaders()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *headerWriter) Write(b []byte) (int, error) {
+	w.setHeaders()
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush は、SSEやmultipart/mixedのトランスポートのために実装する
+func (w *headerWriter) Flush() {
+	w.setHeaders()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack は、websocketのトランスポートのために実装する
+func (w *headerWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("hijack not supported")
+	}
+	return hj.Hijack()
+}
+
+func (w *headerWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,144 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestLimiter は、時刻を進められるLimiterを作る
+func newTestLimiter(userLimit, anonymousLimit int, window time.Duration) (*Limiter, func(time.Duration)) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := New(userLimit, anonymousLimit, window)
+	l.now = func() time.Time { return now }
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestCharge(t *testing.T) {
+	l, _ := newTestLimiter(10, 1, time.Hour)
+
+	tests := []struct {
+		name          string
+		key           string
+		cost          int
+		wantAllowed   bool
+		wantRemaining int
+	}{
+		{name: "first", key: "user:a", cost: 4, wantAllowed: true, wantRemaining: 6},
+		{name: "second", key: "user:a", cost: 6, wantAllowed: true, wantRemaining: 0},
+		{name: "exhausted", key: "user:a", cost: 1, wantAllowed: false, wantRemaining: 0},
+		// 負のコストで予算は戻らない
+		{name: "negative cost", key: "user:a", cost: -100000, wantAllowed: true, wantRemaining: 0},
+		{name: "still exhausted", key: "user:a", cost: 1, wantAllowed: false, wantRemaining: 0},
+		// 予算はkeyごとに別
+		{name: "other user", key: "user:b", cost: 3, wantAllowed: true, wantRemaining: 7},
+	}
+	for _, tt := range tests {
+		status, allowed := l.charge(tt.key, 10, tt.cost)
+		if allowed != tt.wantAllowed || status.Remaining != tt.wantRemaining {
+			t.Errorf("%s: want allowed=%v remaining=%d, but got allowed=%v %+v",
+				tt.name, tt.wantAllowed, tt.wantRemaining, allowed, status)
+		}
+		if status.Cost < 0 {
+			t.Errorf("%s: cost must not be negative: %+v", tt.name, status)
+		}
+	}
+}
+
+func TestChargeResetsAfterWindow(t *testing.T) {
+	l, advance := newTestLimiter(10, 1, time.Hour)
+
+	first, _ := l.charge("user:a", 10, 10)
+	if _, allowed := l.charge("user:a", 10, 1); allowed {
+		t.Fatal("budget should be exhausted")
+	}
+
+	advance(59 * time.Minute)
+	if _, allowed := l.charge("user:a", 10, 1); allowed {
+		t.Error("budget should not be reset before the window ends")
+	}
+
+	advance(time.Minute)
+	status, allowed := l.charge("user:a", 10, 1)
+	if !allowed || status.Remaining != 9 {
+		t.Errorf("budget should be reset: allowed=%v %+v", allowed, status)
+	}
+	if !status.ResetAt.After(first.ResetAt) {
+		t.Errorf("reset time should move forward: %v, %v", first.ResetAt, status.ResetAt)
+	}
+}
+
+func TestSweep(t *testing.T) {
+	l, advance := newTestLimiter(10, 1, time.Hour)
+
+	l.charge("ip:1", 1, 1)
+	advance(30 * time.Minute)
+	l.charge("ip:2", 1, 1)
+	if len(l.buckets) != 2 {
+		t.Fatalf("want 2 buckets, but got %d", len(l.buckets))
+	}
+
+	// ip:1の期限だけが切れている
+	advance(45 * time.Minute)
+	l.charge("ip:3", 1, 1)
+	if _, ok := l.buckets["ip:1"]; ok {
+		t.Error("expired bucket should be swept")
+	}
+	if _, ok := l.buckets["ip:2"]; !ok {
+		t.Error("live bucket should be kept")
+	}
+}
+
+func TestMiddlewareHeaders(t *testing.T) {
+	l, _ := newTestLimiter(10, 1, time.Hour)
+
+	var gotIP string
+	h := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotIP, _ = req.Context().Value(clientIPKey{}).(string)
+		// MutateOperationContextが行うのと同じく、予算の状況を渡す
+		status, _ := l.charge("user:a", 10, 3)
+		req.Context().Value(statusKey{}).(*atomic.Pointer[Status]).Store(status)
+		w.Write([]byte("{}"))
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/query", nil)
+	req.RemoteAddr = "192.0.2.1:12345"
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if gotIP != "192.0.2.1" {
+		t.Errorf("want client ip 192.0.2.1, but got %q", gotIP)
+	}
+	want := map[string]string{
+		"X-RateLimit-Limit":     "10",
+		"X-RateLimit-Remaining": "7",
+		"X-RateLimit-Used":      "3",
+		"X-RateLimit-Reset":     "1704070800",
+	}
+	for name, value := range want {
+		if got := rec.Header().Get(name); got != value {
+			t.Errorf("want %s %s, but got %q", name, value, got)
+		}
+	}
+}
+
+func TestMiddlewareWithoutStatus(t *testing.T) {
+	l, _ := newTestLimiter(10, 1, time.Hour)
+
+	// オペレーションを実行する前に失敗した場合は、ヘッダを付けない
+	h := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/query", nil).WithContext(context.Background()))
+
+	if got := rec.Header().Get("X-RateLimit-Remaining"); got != "" {
+		t.Errorf("want no header, but got %q", got)
+	}
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("want status 400, but got %d", rec.Code)
+	}
+}
@@ -7,9 +7,13 @@ package services
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/saki-engineering/graphql-sample/graph/model"
+	scope "github.com/saki-engineering/graphql-sample/graph/scope"
+	services "github.com/saki-engineering/graphql-sample/graph/services"
+	auth "github.com/saki-engineering/graphql-sample/middlewares/auth"
 )
 
 // MockServices is a mock of Services interface.
@@ -35,6 +39,21 @@ func (m *MockServices) EXPECT() *MockServicesMockRecorder {
 	return m.recorder
 }
 
+// AcceptRepositoryInvitation mocks base method.
+func (m *MockServices) AcceptRepositoryInvitation(ctx context.Context, id string) (*model.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptRepositoryInvitation", ctx, id)
+	ret0, _ := ret[0].(*model.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptRepositoryInvitation indicates an expected call of AcceptRepositoryInvitation.
+func (mr *MockServicesMockRecorder) AcceptRepositoryInvitation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptRepositoryInvitation", reflect.TypeOf((*MockServices)(nil).AcceptRepositoryInvitation), ctx, id)
+}
+
 // AddIssueInProjectV2 mocks base method.
 func (m *MockServices) AddIssueInProjectV2(ctx context.Context, projectID, issueID string) (*model.ProjectV2Item, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +84,127 @@ func (mr *MockServicesMockRecorder) AddPullRequestInProjectV2(ctx, projectID, pu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPullRequestInProjectV2", reflect.TypeOf((*MockServices)(nil).AddPullRequestInProjectV2), ctx, projectID, pullRequestID)
 }
 
+// AuthenticateSession mocks base method.
+func (m *MockServices) AuthenticateSession(ctx context.Context, token string) (*model.User, *auth.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateSession", ctx, token)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(*auth.Session)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticateSession indicates an expected call of AuthenticateSession.
+func (mr *MockServicesMockRecorder) AuthenticateSession(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateSession", reflect.TypeOf((*MockServices)(nil).AuthenticateSession), ctx, token)
+}
+
+// AuthenticateToken mocks base method.
+func (m *MockServices) AuthenticateToken(ctx context.Context, token string) (*model.User, scope.Set, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateToken", ctx, token)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(scope.Set)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticateToken indicates an expected call of AuthenticateToken.
+func (mr *MockServicesMockRecorder) AuthenticateToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateToken", reflect.TypeOf((*MockServices)(nil).AuthenticateToken), ctx, token)
+}
+
+// BlockUser mocks base method.
+func (m *MockServices) BlockUser(ctx context.Context, userID string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", ctx, userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockServicesMockRecorder) BlockUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockServices)(nil).BlockUser), ctx, userID)
+}
+
+// CreateIssue mocks base method.
+func (m *MockServices) CreateIssue(ctx context.Context, repoID, authorID, title string) (*model.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssue", ctx, repoID, authorID, title)
+	ret0, _ := ret[0].(*model.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssue indicates an expected call of CreateIssue.
+func (mr *MockServicesMockRecorder) CreateIssue(ctx, repoID, authorID, title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssue", reflect.TypeOf((*MockServices)(nil).CreateIssue), ctx, repoID, authorID, title)
+}
+
+// CreatePersonalAccessToken mocks base method.
+func (m *MockServices) CreatePersonalAccessToken(ctx context.Context, ownerID, name string, scopes scope.Set, expiresAt *time.Time) (*model.PersonalAccessToken, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalAccessToken", ctx, ownerID, name, scopes, expiresAt)
+	ret0, _ := ret[0].(*model.PersonalAccessToken)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePersonalAccessToken indicates an expected call of CreatePersonalAccessToken.
+func (mr *MockServicesMockRecorder) CreatePersonalAccessToken(ctx, ownerID, name, scopes, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalAccessToken", reflect.TypeOf((*MockServices)(nil).CreatePersonalAccessToken), ctx, ownerID, name, scopes, expiresAt)
+}
+
+// DeclineRepositoryInvitation mocks base method.
+func (m *MockServices) DeclineRepositoryInvitation(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineRepositoryInvitation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineRepositoryInvitation indicates an expected call of DeclineRepositoryInvitation.
+func (mr *MockServicesMockRecorder) DeclineRepositoryInvitation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineRepositoryInvitation", reflect.TypeOf((*MockServices)(nil).DeclineRepositoryInvitation), ctx, id)
+}
+
+// DeleteProjectV2Item mocks base method.
+func (m *MockServices) DeleteProjectV2Item(ctx context.Context, projectID, itemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectV2Item", ctx, projectID, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectV2Item indicates an expected call of DeleteProjectV2Item.
+func (mr *MockServicesMockRecorder) DeleteProjectV2Item(ctx, projectID, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectV2Item", reflect.TypeOf((*MockServices)(nil).DeleteProjectV2Item), ctx, projectID, itemID)
+}
+
+// GetAuditLogEntry mocks base method.
+func (m *MockServices) GetAuditLogEntry(ctx context.Context, id string) (*model.AuditLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogEntry", ctx, id)
+	ret0, _ := ret[0].(*model.AuditLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogEntry indicates an expected call of GetAuditLogEntry.
+func (mr *MockServicesMockRecorder) GetAuditLogEntry(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogEntry", reflect.TypeOf((*MockServices)(nil).GetAuditLogEntry), ctx, id)
+}
+
 // GetIssueByID mocks base method.
 func (m *MockServices) GetIssueByID(ctx context.Context, id string) (*model.Issue, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +235,21 @@ func (mr *MockServicesMockRecorder) GetIssueByRepoAndNumber(ctx, repoID, number
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssueByRepoAndNumber", reflect.TypeOf((*MockServices)(nil).GetIssueByRepoAndNumber), ctx, repoID, number)
 }
 
+// GetPersonalAccessToken mocks base method.
+func (m *MockServices) GetPersonalAccessToken(ctx context.Context, ownerID, id string) (*model.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalAccessToken", ctx, ownerID, id)
+	ret0, _ := ret[0].(*model.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalAccessToken indicates an expected call of GetPersonalAccessToken.
+func (mr *MockServicesMockRecorder) GetPersonalAccessToken(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalAccessToken", reflect.TypeOf((*MockServices)(nil).GetPersonalAccessToken), ctx, ownerID, id)
+}
+
 // GetProjectByID mocks base method.
 func (m *MockServices) GetProjectByID(ctx context.Context, id string) (*model.ProjectV2, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +355,36 @@ func (mr *MockServicesMockRecorder) GetRepoByID(ctx, id interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepoByID", reflect.TypeOf((*MockServices)(nil).GetRepoByID), ctx, id)
 }
 
+// GetRepositoryInteractionAbility mocks base method.
+func (m *MockServices) GetRepositoryInteractionAbility(ctx context.Context, repoID string) (*model.RepositoryInteractionAbility, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryInteractionAbility", ctx, repoID)
+	ret0, _ := ret[0].(*model.RepositoryInteractionAbility)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryInteractionAbility indicates an expected call of GetRepositoryInteractionAbility.
+func (mr *MockServicesMockRecorder) GetRepositoryInteractionAbility(ctx, repoID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryInteractionAbility", reflect.TypeOf((*MockServices)(nil).GetRepositoryInteractionAbility), ctx, repoID)
+}
+
+// GetRepositoryInvitation mocks base method.
+func (m *MockServices) GetRepositoryInvitation(ctx context.Context, id string) (*model.RepositoryInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryInvitation", ctx, id)
+	ret0, _ := ret[0].(*model.RepositoryInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryInvitation indicates an expected call of GetRepositoryInvitation.
+func (mr *MockServicesMockRecorder) GetRepositoryInvitation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryInvitation", reflect.TypeOf((*MockServices)(nil).GetRepositoryInvitation), ctx, id)
+}
+
 // GetUserByID mocks base method.
 func (m *MockServices) GetUserByID(ctx context.Context, id string) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -230,6 +415,81 @@ func (mr *MockServicesMockRecorder) GetUserByName(ctx, name interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByName", reflect.TypeOf((*MockServices)(nil).GetUserByName), ctx, name)
 }
 
+// GetViewerPermission mocks base method.
+func (m *MockServices) GetViewerPermission(ctx context.Context, repoID string) (model.RepositoryPermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewerPermission", ctx, repoID)
+	ret0, _ := ret[0].(model.RepositoryPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetViewerPermission indicates an expected call of GetViewerPermission.
+func (mr *MockServicesMockRecorder) GetViewerPermission(ctx, repoID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewerPermission", reflect.TypeOf((*MockServices)(nil).GetViewerPermission), ctx, repoID)
+}
+
+// ImpersonateUser mocks base method.
+func (m *MockServices) ImpersonateUser(ctx context.Context, name string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImpersonateUser", ctx, name)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImpersonateUser indicates an expected call of ImpersonateUser.
+func (mr *MockServicesMockRecorder) ImpersonateUser(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImpersonateUser", reflect.TypeOf((*MockServices)(nil).ImpersonateUser), ctx, name)
+}
+
+// InviteCollaborator mocks base method.
+func (m *MockServices) InviteCollaborator(ctx context.Context, repoID, inviteeID string, permission model.RepositoryPermission) (*model.RepositoryInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteCollaborator", ctx, repoID, inviteeID, permission)
+	ret0, _ := ret[0].(*model.RepositoryInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteCollaborator indicates an expected call of InviteCollaborator.
+func (mr *MockServicesMockRecorder) InviteCollaborator(ctx, repoID, inviteeID, permission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteCollaborator", reflect.TypeOf((*MockServices)(nil).InviteCollaborator), ctx, repoID, inviteeID, permission)
+}
+
+// IsBlockedByViewer mocks base method.
+func (m *MockServices) IsBlockedByViewer(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlockedByViewer", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlockedByViewer indicates an expected call of IsBlockedByViewer.
+func (mr *MockServicesMockRecorder) IsBlockedByViewer(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlockedByViewer", reflect.TypeOf((*MockServices)(nil).IsBlockedByViewer), ctx, userID)
+}
+
+// ListAuditLog mocks base method.
+func (m *MockServices) ListAuditLog(ctx context.Context, filter *model.AuditLogFilter, after *string, first *int) (*model.AuditLogEntryConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLog", ctx, filter, after, first)
+	ret0, _ := ret[0].(*model.AuditLogEntryConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLog indicates an expected call of ListAuditLog.
+func (mr *MockServicesMockRecorder) ListAuditLog(ctx, filter, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLog", reflect.TypeOf((*MockServices)(nil).ListAuditLog), ctx, filter, after, first)
+}
+
 // ListIssueInRepository mocks base method.
 func (m *MockServices) ListIssueInRepository(ctx context.Context, repoID string, after, before *string, first, last *int) (*model.IssueConnection, error) {
 	m.ctrl.T.Helper()
@@ -245,6 +505,21 @@ func (mr *MockServicesMockRecorder) ListIssueInRepository(ctx, repoID, after, be
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueInRepository", reflect.TypeOf((*MockServices)(nil).ListIssueInRepository), ctx, repoID, after, before, first, last)
 }
 
+// ListIssuesByID mocks base method.
+func (m *MockServices) ListIssuesByID(ctx context.Context, IDs []string) ([]*model.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssuesByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssuesByID indicates an expected call of ListIssuesByID.
+func (mr *MockServicesMockRecorder) ListIssuesByID(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssuesByID", reflect.TypeOf((*MockServices)(nil).ListIssuesByID), ctx, IDs)
+}
+
 // ListProjectByOwner mocks base method.
 func (m *MockServices) ListProjectByOwner(ctx context.Context, ownerID string, after, before *string, first, last *int) (*model.ProjectV2Connection, error) {
 	m.ctrl.T.Helper()
@@ -305,6 +580,36 @@ func (mr *MockServicesMockRecorder) ListProjectItemOwnedByPullRequest(ctx, pullR
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectItemOwnedByPullRequest", reflect.TypeOf((*MockServices)(nil).ListProjectItemOwnedByPullRequest), ctx, pullRequestID, after, before, first, last)
 }
 
+// ListProjectItemsByID mocks base method.
+func (m *MockServices) ListProjectItemsByID(ctx context.Context, IDs []string) ([]*model.ProjectV2Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectItemsByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.ProjectV2Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectItemsByID indicates an expected call of ListProjectItemsByID.
+func (mr *MockServicesMockRecorder) ListProjectItemsByID(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectItemsByID", reflect.TypeOf((*MockServices)(nil).ListProjectItemsByID), ctx, IDs)
+}
+
+// ListProjectsByID mocks base method.
+func (m *MockServices) ListProjectsByID(ctx context.Context, IDs []string) ([]*model.ProjectV2, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.ProjectV2)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsByID indicates an expected call of ListProjectsByID.
+func (mr *MockServicesMockRecorder) ListProjectsByID(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsByID", reflect.TypeOf((*MockServices)(nil).ListProjectsByID), ctx, IDs)
+}
+
 // ListPullRequestInRepository mocks base method.
 func (m *MockServices) ListPullRequestInRepository(ctx context.Context, repoID string, after, before *string, first, last *int) (*model.PullRequestConnection, error) {
 	m.ctrl.T.Helper()
@@ -320,6 +625,36 @@ func (mr *MockServicesMockRecorder) ListPullRequestInRepository(ctx, repoID, aft
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequestInRepository", reflect.TypeOf((*MockServices)(nil).ListPullRequestInRepository), ctx, repoID, after, before, first, last)
 }
 
+// ListPullRequestsByID mocks base method.
+func (m *MockServices) ListPullRequestsByID(ctx context.Context, IDs []string) ([]*model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequestsByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullRequestsByID indicates an expected call of ListPullRequestsByID.
+func (mr *MockServicesMockRecorder) ListPullRequestsByID(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequestsByID", reflect.TypeOf((*MockServices)(nil).ListPullRequestsByID), ctx, IDs)
+}
+
+// ListReposByID mocks base method.
+func (m *MockServices) ListReposByID(ctx context.Context, IDs []string) ([]*model.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReposByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReposByID indicates an expected call of ListReposByID.
+func (mr *MockServicesMockRecorder) ListReposByID(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReposByID", reflect.TypeOf((*MockServices)(nil).ListReposByID), ctx, IDs)
+}
+
 // ListUsersByID mocks base method.
 func (m *MockServices) ListUsersByID(ctx context.Context, IDs []string) ([]*model.User, error) {
 	m.ctrl.T.Helper()
@@ -335,125 +670,449 @@ func (mr *MockServicesMockRecorder) ListUsersByID(ctx, IDs interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersByID", reflect.TypeOf((*MockServices)(nil).ListUsersByID), ctx, IDs)
 }
 
-// MockUserService is a mock of UserService interface.
-type MockUserService struct {
-	ctrl     *gomock.Controller
-	recorder *MockUserServiceMockRecorder
-}
-
-// MockUserServiceMockRecorder is the mock recorder for MockUserService.
-type MockUserServiceMockRecorder struct {
-	mock *MockUserService
-}
-
-// NewMockUserService creates a new mock instance.
-func NewMockUserService(ctrl *gomock.Controller) *MockUserService {
-	mock := &MockUserService{ctrl: ctrl}
-	mock.recorder = &MockUserServiceMockRecorder{mock}
-	return mock
+// ListViewerRepositoryInvitations mocks base method.
+func (m *MockServices) ListViewerRepositoryInvitations(ctx context.Context) ([]*model.RepositoryInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListViewerRepositoryInvitations", ctx)
+	ret0, _ := ret[0].([]*model.RepositoryInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
-	return m.recorder
+// ListViewerRepositoryInvitations indicates an expected call of ListViewerRepositoryInvitations.
+func (mr *MockServicesMockRecorder) ListViewerRepositoryInvitations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListViewerRepositoryInvitations", reflect.TypeOf((*MockServices)(nil).ListViewerRepositoryInvitations), ctx)
 }
 
-// GetUserByID mocks base method.
-func (m *MockUserService) GetUserByID(ctx context.Context, id string) (*model.User, error) {
+// Login mocks base method.
+func (m *MockServices) Login(ctx context.Context, name, password string) (*model.User, *auth.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret := m.ctrl.Call(m, "Login", ctx, name, password)
 	ret0, _ := ret[0].(*model.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*auth.Session)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetUserByID indicates an expected call of GetUserByID.
-func (mr *MockUserServiceMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+// Login indicates an expected call of Login.
+func (mr *MockServicesMockRecorder) Login(ctx, name, password interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserService)(nil).GetUserByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockServices)(nil).Login), ctx, name, password)
 }
 
-// GetUserByName mocks base method.
-func (m *MockUserService) GetUserByName(ctx context.Context, name string) (*model.User, error) {
+// Logout mocks base method.
+func (m *MockServices) Logout(ctx context.Context, token string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByName", ctx, name)
-	ret0, _ := ret[0].(*model.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Logout", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetUserByName indicates an expected call of GetUserByName.
-func (mr *MockUserServiceMockRecorder) GetUserByName(ctx, name interface{}) *gomock.Call {
+// Logout indicates an expected call of Logout.
+func (mr *MockServicesMockRecorder) Logout(ctx, token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByName", reflect.TypeOf((*MockUserService)(nil).GetUserByName), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockServices)(nil).Logout), ctx, token)
 }
 
-// ListUsersByID mocks base method.
-func (m *MockUserService) ListUsersByID(ctx context.Context, IDs []string) ([]*model.User, error) {
+// RecordAuditLog mocks base method.
+func (m *MockServices) RecordAuditLog(ctx context.Context, entry *model.AuditLogEntry) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsersByID", ctx, IDs)
-	ret0, _ := ret[0].([]*model.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RecordAuditLog", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListUsersByID indicates an expected call of ListUsersByID.
-func (mr *MockUserServiceMockRecorder) ListUsersByID(ctx, IDs interface{}) *gomock.Call {
+// RecordAuditLog indicates an expected call of RecordAuditLog.
+func (mr *MockServicesMockRecorder) RecordAuditLog(ctx, entry interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersByID", reflect.TypeOf((*MockUserService)(nil).ListUsersByID), ctx, IDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuditLog", reflect.TypeOf((*MockServices)(nil).RecordAuditLog), ctx, entry)
 }
 
-// MockRepoService is a mock of RepoService interface.
-type MockRepoService struct {
-	ctrl     *gomock.Controller
-	recorder *MockRepoServiceMockRecorder
+// RecordImpersonation mocks base method.
+func (m *MockServices) RecordImpersonation(ctx context.Context, adminID, targetID, operationName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordImpersonation", ctx, adminID, targetID, operationName)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockRepoServiceMockRecorder is the mock recorder for MockRepoService.
-type MockRepoServiceMockRecorder struct {
-	mock *MockRepoService
+// RecordImpersonation indicates an expected call of RecordImpersonation.
+func (mr *MockServicesMockRecorder) RecordImpersonation(ctx, adminID, targetID, operationName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordImpersonation", reflect.TypeOf((*MockServices)(nil).RecordImpersonation), ctx, adminID, targetID, operationName)
 }
 
-// NewMockRepoService creates a new mock instance.
-func NewMockRepoService(ctrl *gomock.Controller) *MockRepoService {
-	mock := &MockRepoService{ctrl: ctrl}
-	mock.recorder = &MockRepoServiceMockRecorder{mock}
-	return mock
+// RevokePersonalAccessToken mocks base method.
+func (m *MockServices) RevokePersonalAccessToken(ctx context.Context, ownerID, id string) (*model.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePersonalAccessToken", ctx, ownerID, id)
+	ret0, _ := ret[0].(*model.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRepoService) EXPECT() *MockRepoServiceMockRecorder {
-	return m.recorder
+// RevokePersonalAccessToken indicates an expected call of RevokePersonalAccessToken.
+func (mr *MockServicesMockRecorder) RevokePersonalAccessToken(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePersonalAccessToken", reflect.TypeOf((*MockServices)(nil).RevokePersonalAccessToken), ctx, ownerID, id)
 }
 
-// GetRepoByFullName mocks base method.
-func (m *MockRepoService) GetRepoByFullName(ctx context.Context, owner, name string) (*model.Repository, error) {
+// SetProjectV2ItemArchived mocks base method.
+func (m *MockServices) SetProjectV2ItemArchived(ctx context.Context, projectID, itemID string, archived bool) (*model.ProjectV2Item, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRepoByFullName", ctx, owner, name)
-	ret0, _ := ret[0].(*model.Repository)
+	ret := m.ctrl.Call(m, "SetProjectV2ItemArchived", ctx, projectID, itemID, archived)
+	ret0, _ := ret[0].(*model.ProjectV2Item)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRepoByFullName indicates an expected call of GetRepoByFullName.
-func (mr *MockRepoServiceMockRecorder) GetRepoByFullName(ctx, owner, name interface{}) *gomock.Call {
+// SetProjectV2ItemArchived indicates an expected call of SetProjectV2ItemArchived.
+func (mr *MockServicesMockRecorder) SetProjectV2ItemArchived(ctx, projectID, itemID, archived interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepoByFullName", reflect.TypeOf((*MockRepoService)(nil).GetRepoByFullName), ctx, owner, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectV2ItemArchived", reflect.TypeOf((*MockServices)(nil).SetProjectV2ItemArchived), ctx, projectID, itemID, archived)
 }
 
-// GetRepoByID mocks base method.
-func (m *MockRepoService) GetRepoByID(ctx context.Context, id string) (*model.Repository, error) {
+// SetRepositoryInteractionLimit mocks base method.
+func (m *MockServices) SetRepositoryInteractionLimit(ctx context.Context, repoID string, limit model.RepositoryInteractionLimit, expiry model.RepositoryInteractionLimitExpiry) (*model.Repository, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRepoByID", ctx, id)
+	ret := m.ctrl.Call(m, "SetRepositoryInteractionLimit", ctx, repoID, limit, expiry)
 	ret0, _ := ret[0].(*model.Repository)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRepoByID indicates an expected call of GetRepoByID.
-func (mr *MockRepoServiceMockRecorder) GetRepoByID(ctx, id interface{}) *gomock.Call {
+// SetRepositoryInteractionLimit indicates an expected call of SetRepositoryInteractionLimit.
+func (mr *MockServicesMockRecorder) SetRepositoryInteractionLimit(ctx, repoID, limit, expiry interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepoByID", reflect.TypeOf((*MockRepoService)(nil).GetRepoByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRepositoryInteractionLimit", reflect.TypeOf((*MockServices)(nil).SetRepositoryInteractionLimit), ctx, repoID, limit, expiry)
+}
+
+// SignUp mocks base method.
+func (m *MockServices) SignUp(ctx context.Context, name, password string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignUp", ctx, name, password)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignUp indicates an expected call of SignUp.
+func (mr *MockServicesMockRecorder) SignUp(ctx, name, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockServices)(nil).SignUp), ctx, name, password)
+}
+
+// SubscribeIssueEvents mocks base method.
+func (m *MockServices) SubscribeIssueEvents(ctx context.Context, repoID string) <-chan *services.IssueEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeIssueEvents", ctx, repoID)
+	ret0, _ := ret[0].(<-chan *services.IssueEvent)
+	return ret0
+}
+
+// SubscribeIssueEvents indicates an expected call of SubscribeIssueEvents.
+func (mr *MockServicesMockRecorder) SubscribeIssueEvents(ctx, repoID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeIssueEvents", reflect.TypeOf((*MockServices)(nil).SubscribeIssueEvents), ctx, repoID)
+}
+
+// SubscribeProjectItemEvents mocks base method.
+func (m *MockServices) SubscribeProjectItemEvents(ctx context.Context, projectID string) <-chan *services.ProjectItemEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeProjectItemEvents", ctx, projectID)
+	ret0, _ := ret[0].(<-chan *services.ProjectItemEvent)
+	return ret0
+}
+
+// SubscribeProjectItemEvents indicates an expected call of SubscribeProjectItemEvents.
+func (mr *MockServicesMockRecorder) SubscribeProjectItemEvents(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeProjectItemEvents", reflect.TypeOf((*MockServices)(nil).SubscribeProjectItemEvents), ctx, projectID)
+}
+
+// SubscribePullRequestEvents mocks base method.
+func (m *MockServices) SubscribePullRequestEvents(ctx context.Context, repoID string) <-chan *services.PullRequestEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribePullRequestEvents", ctx, repoID)
+	ret0, _ := ret[0].(<-chan *services.PullRequestEvent)
+	return ret0
+}
+
+// SubscribePullRequestEvents indicates an expected call of SubscribePullRequestEvents.
+func (mr *MockServicesMockRecorder) SubscribePullRequestEvents(ctx, repoID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribePullRequestEvents", reflect.TypeOf((*MockServices)(nil).SubscribePullRequestEvents), ctx, repoID)
+}
+
+// UnblockUser mocks base method.
+func (m *MockServices) UnblockUser(ctx context.Context, userID string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", ctx, userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockServicesMockRecorder) UnblockUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockServices)(nil).UnblockUser), ctx, userID)
+}
+
+// UpdateIssue mocks base method.
+func (m *MockServices) UpdateIssue(ctx context.Context, id string, title *string, closed *bool) (*model.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssue", ctx, id, title, closed)
+	ret0, _ := ret[0].(*model.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIssue indicates an expected call of UpdateIssue.
+func (mr *MockServicesMockRecorder) UpdateIssue(ctx, id, title, closed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssue", reflect.TypeOf((*MockServices)(nil).UpdateIssue), ctx, id, title, closed)
+}
+
+// UpdatePullRequest mocks base method.
+func (m *MockServices) UpdatePullRequest(ctx context.Context, id string, baseRefName *string, closed *bool) (*model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePullRequest", ctx, id, baseRefName, closed)
+	ret0, _ := ret[0].(*model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePullRequest indicates an expected call of UpdatePullRequest.
+func (mr *MockServicesMockRecorder) UpdatePullRequest(ctx, id, baseRefName, closed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequest", reflect.TypeOf((*MockServices)(nil).UpdatePullRequest), ctx, id, baseRefName, closed)
+}
+
+// MockUserService is a mock of UserService interface.
+type MockUserService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserServiceMockRecorder
+}
+
+// MockUserServiceMockRecorder is the mock recorder for MockUserService.
+type MockUserServiceMockRecorder struct {
+	mock *MockUserService
+}
+
+// NewMockUserService creates a new mock instance.
+func NewMockUserService(ctrl *gomock.Controller) *MockUserService {
+	mock := &MockUserService{ctrl: ctrl}
+	mock.recorder = &MockUserServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
+	return m.recorder
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserService) GetUserByID(ctx context.Context, id string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserServiceMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserService)(nil).GetUserByID), ctx, id)
+}
+
+// GetUserByName mocks base method.
+func (m *MockUserService) GetUserByName(ctx context.Context, name string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByName", ctx, name)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByName indicates an expected call of GetUserByName.
+func (mr *MockUserServiceMockRecorder) GetUserByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByName", reflect.TypeOf((*MockUserService)(nil).GetUserByName), ctx, name)
+}
+
+// ListUsersByID mocks base method.
+func (m *MockUserService) ListUsersByID(ctx context.Context, IDs []string) ([]*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersByID indicates an expected call of ListUsersByID.
+func (mr *MockUserServiceMockRecorder) ListUsersByID(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersByID", reflect.TypeOf((*MockUserService)(nil).ListUsersByID), ctx, IDs)
+}
+
+// MockRepoService is a mock of RepoService interface.
+type MockRepoService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepoServiceMockRecorder
+}
+
+// MockRepoServiceMockRecorder is the mock recorder for MockRepoService.
+type MockRepoServiceMockRecorder struct {
+	mock *MockRepoService
+}
+
+// NewMockRepoService creates a new mock instance.
+func NewMockRepoService(ctrl *gomock.Controller) *MockRepoService {
+	mock := &MockRepoService{ctrl: ctrl}
+	mock.recorder = &MockRepoServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepoService) EXPECT() *MockRepoServiceMockRecorder {
+	return m.recorder
+}
+
+// AcceptRepositoryInvitation mocks base method.
+func (m *MockRepoService) AcceptRepositoryInvitation(ctx context.Context, id string) (*model.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptRepositoryInvitation", ctx, id)
+	ret0, _ := ret[0].(*model.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptRepositoryInvitation indicates an expected call of AcceptRepositoryInvitation.
+func (mr *MockRepoServiceMockRecorder) AcceptRepositoryInvitation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptRepositoryInvitation", reflect.TypeOf((*MockRepoService)(nil).AcceptRepositoryInvitation), ctx, id)
+}
+
+// DeclineRepositoryInvitation mocks base method.
+func (m *MockRepoService) DeclineRepositoryInvitation(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineRepositoryInvitation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineRepositoryInvitation indicates an expected call of DeclineRepositoryInvitation.
+func (mr *MockRepoServiceMockRecorder) DeclineRepositoryInvitation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineRepositoryInvitation", reflect.TypeOf((*MockRepoService)(nil).DeclineRepositoryInvitation), ctx, id)
+}
+
+// GetRepoByFullName mocks base method.
+func (m *MockRepoService) GetRepoByFullName(ctx context.Context, owner, name string) (*model.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepoByFullName", ctx, owner, name)
+	ret0, _ := ret[0].(*model.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepoByFullName indicates an expected call of GetRepoByFullName.
+func (mr *MockRepoServiceMockRecorder) GetRepoByFullName(ctx, owner, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepoByFullName", reflect.TypeOf((*MockRepoService)(nil).GetRepoByFullName), ctx, owner, name)
+}
+
+// GetRepoByID mocks base method.
+func (m *MockRepoService) GetRepoByID(ctx context.Context, id string) (*model.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepoByID", ctx, id)
+	ret0, _ := ret[0].(*model.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepoByID indicates an expected call of GetRepoByID.
+func (mr *MockRepoServiceMockRecorder) GetRepoByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepoByID", reflect.TypeOf((*MockRepoService)(nil).GetRepoByID), ctx, id)
+}
+
+// GetRepositoryInvitation mocks base method.
+func (m *MockRepoService) GetRepositoryInvitation(ctx context.Context, id string) (*model.RepositoryInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryInvitation", ctx, id)
+	ret0, _ := ret[0].(*model.RepositoryInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryInvitation indicates an expected call of GetRepositoryInvitation.
+func (mr *MockRepoServiceMockRecorder) GetRepositoryInvitation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryInvitation", reflect.TypeOf((*MockRepoService)(nil).GetRepositoryInvitation), ctx, id)
+}
+
+// GetViewerPermission mocks base method.
+func (m *MockRepoService) GetViewerPermission(ctx context.Context, repoID string) (model.RepositoryPermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewerPermission", ctx, repoID)
+	ret0, _ := ret[0].(model.RepositoryPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetViewerPermission indicates an expected call of GetViewerPermission.
+func (mr *MockRepoServiceMockRecorder) GetViewerPermission(ctx, repoID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewerPermission", reflect.TypeOf((*MockRepoService)(nil).GetViewerPermission), ctx, repoID)
+}
+
+// InviteCollaborator mocks base method.
+func (m *MockRepoService) InviteCollaborator(ctx context.Context, repoID, inviteeID string, permission model.RepositoryPermission) (*model.RepositoryInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteCollaborator", ctx, repoID, inviteeID, permission)
+	ret0, _ := ret[0].(*model.RepositoryInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteCollaborator indicates an expected call of InviteCollaborator.
+func (mr *MockRepoServiceMockRecorder) InviteCollaborator(ctx, repoID, inviteeID, permission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteCollaborator", reflect.TypeOf((*MockRepoService)(nil).InviteCollaborator), ctx, repoID, inviteeID, permission)
+}
+
+// ListReposByID mocks base method.
+func (m *MockRepoService) ListReposByID(ctx context.Context, IDs []string) ([]*model.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReposByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReposByID indicates an expected call of ListReposByID.
+func (mr *MockRepoServiceMockRecorder) ListReposByID(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReposByID", reflect.TypeOf((*MockRepoService)(nil).ListReposByID), ctx, IDs)
+}
+
+// ListViewerRepositoryInvitations mocks base method.
+func (m *MockRepoService) ListViewerRepositoryInvitations(ctx context.Context) ([]*model.RepositoryInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListViewerRepositoryInvitations", ctx)
+	ret0, _ := ret[0].([]*model.RepositoryInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListViewerRepositoryInvitations indicates an expected call of ListViewerRepositoryInvitations.
+func (mr *MockRepoServiceMockRecorder) ListViewerRepositoryInvitations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListViewerRepositoryInvitations", reflect.TypeOf((*MockRepoService)(nil).ListViewerRepositoryInvitations), ctx)
 }
 
 // MockIssueService is a mock of IssueService interface.
@@ -479,6 +1138,21 @@ func (m *MockIssueService) EXPECT() *MockIssueServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateIssue mocks base method.
+func (m *MockIssueService) CreateIssue(ctx context.Context, repoID, authorID, title string) (*model.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssue", ctx, repoID, authorID, title)
+	ret0, _ := ret[0].(*model.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssue indicates an expected call of CreateIssue.
+func (mr *MockIssueServiceMockRecorder) CreateIssue(ctx, repoID, authorID, title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssue", reflect.TypeOf((*MockIssueService)(nil).CreateIssue), ctx, repoID, authorID, title)
+}
+
 // GetIssueByID mocks base method.
 func (m *MockIssueService) GetIssueByID(ctx context.Context, id string) (*model.Issue, error) {
 	m.ctrl.T.Helper()
@@ -524,6 +1198,50 @@ func (mr *MockIssueServiceMockRecorder) ListIssueInRepository(ctx, repoID, after
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssueInRepository", reflect.TypeOf((*MockIssueService)(nil).ListIssueInRepository), ctx, repoID, after, before, first, last)
 }
 
+// ListIssuesByID mocks base method.
+func (m *MockIssueService) ListIssuesByID(ctx context.Context, IDs []string) ([]*model.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssuesByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssuesByID indicates an expected call of ListIssuesByID.
+func (mr *MockIssueServiceMockRecorder) ListIssuesByID(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssuesByID", reflect.TypeOf((*MockIssueService)(nil).ListIssuesByID), ctx, IDs)
+}
+
+// SubscribeIssueEvents mocks base method.
+func (m *MockIssueService) SubscribeIssueEvents(ctx context.Context, repoID string) <-chan *services.IssueEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeIssueEvents", ctx, repoID)
+	ret0, _ := ret[0].(<-chan *services.IssueEvent)
+	return ret0
+}
+
+// SubscribeIssueEvents indicates an expected call of SubscribeIssueEvents.
+func (mr *MockIssueServiceMockRecorder) SubscribeIssueEvents(ctx, repoID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeIssueEvents", reflect.TypeOf((*MockIssueService)(nil).SubscribeIssueEvents), ctx, repoID)
+}
+
+// UpdateIssue mocks base method.
+func (m *MockIssueService) UpdateIssue(ctx context.Context, id string, title *string, closed *bool) (*model.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssue", ctx, id, title, closed)
+	ret0, _ := ret[0].(*model.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIssue indicates an expected call of UpdateIssue.
+func (mr *MockIssueServiceMockRecorder) UpdateIssue(ctx, id, title, closed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssue", reflect.TypeOf((*MockIssueService)(nil).UpdateIssue), ctx, id, title, closed)
+}
+
 // MockPullRequestService is a mock of PullRequestService interface.
 type MockPullRequestService struct {
 	ctrl     *gomock.Controller
@@ -556,40 +1274,84 @@ func (m *MockPullRequestService) GetPullRequestByID(ctx context.Context, id stri
 	return ret0, ret1
 }
 
-// GetPullRequestByID indicates an expected call of GetPullRequestByID.
-func (mr *MockPullRequestServiceMockRecorder) GetPullRequestByID(ctx, id interface{}) *gomock.Call {
+// GetPullRequestByID indicates an expected call of GetPullRequestByID.
+func (mr *MockPullRequestServiceMockRecorder) GetPullRequestByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestByID", reflect.TypeOf((*MockPullRequestService)(nil).GetPullRequestByID), ctx, id)
+}
+
+// GetPullRequestByRepoAndNumber mocks base method.
+func (m *MockPullRequestService) GetPullRequestByRepoAndNumber(ctx context.Context, repoID string, number int) (*model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestByRepoAndNumber", ctx, repoID, number)
+	ret0, _ := ret[0].(*model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequestByRepoAndNumber indicates an expected call of GetPullRequestByRepoAndNumber.
+func (mr *MockPullRequestServiceMockRecorder) GetPullRequestByRepoAndNumber(ctx, repoID, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestByRepoAndNumber", reflect.TypeOf((*MockPullRequestService)(nil).GetPullRequestByRepoAndNumber), ctx, repoID, number)
+}
+
+// ListPullRequestInRepository mocks base method.
+func (m *MockPullRequestService) ListPullRequestInRepository(ctx context.Context, repoID string, after, before *string, first, last *int) (*model.PullRequestConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequestInRepository", ctx, repoID, after, before, first, last)
+	ret0, _ := ret[0].(*model.PullRequestConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullRequestInRepository indicates an expected call of ListPullRequestInRepository.
+func (mr *MockPullRequestServiceMockRecorder) ListPullRequestInRepository(ctx, repoID, after, before, first, last interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequestInRepository", reflect.TypeOf((*MockPullRequestService)(nil).ListPullRequestInRepository), ctx, repoID, after, before, first, last)
+}
+
+// ListPullRequestsByID mocks base method.
+func (m *MockPullRequestService) ListPullRequestsByID(ctx context.Context, IDs []string) ([]*model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequestsByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullRequestsByID indicates an expected call of ListPullRequestsByID.
+func (mr *MockPullRequestServiceMockRecorder) ListPullRequestsByID(ctx, IDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestByID", reflect.TypeOf((*MockPullRequestService)(nil).GetPullRequestByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequestsByID", reflect.TypeOf((*MockPullRequestService)(nil).ListPullRequestsByID), ctx, IDs)
 }
 
-// GetPullRequestByRepoAndNumber mocks base method.
-func (m *MockPullRequestService) GetPullRequestByRepoAndNumber(ctx context.Context, repoID string, number int) (*model.PullRequest, error) {
+// SubscribePullRequestEvents mocks base method.
+func (m *MockPullRequestService) SubscribePullRequestEvents(ctx context.Context, repoID string) <-chan *services.PullRequestEvent {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPullRequestByRepoAndNumber", ctx, repoID, number)
-	ret0, _ := ret[0].(*model.PullRequest)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SubscribePullRequestEvents", ctx, repoID)
+	ret0, _ := ret[0].(<-chan *services.PullRequestEvent)
+	return ret0
 }
 
-// GetPullRequestByRepoAndNumber indicates an expected call of GetPullRequestByRepoAndNumber.
-func (mr *MockPullRequestServiceMockRecorder) GetPullRequestByRepoAndNumber(ctx, repoID, number interface{}) *gomock.Call {
+// SubscribePullRequestEvents indicates an expected call of SubscribePullRequestEvents.
+func (mr *MockPullRequestServiceMockRecorder) SubscribePullRequestEvents(ctx, repoID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestByRepoAndNumber", reflect.TypeOf((*MockPullRequestService)(nil).GetPullRequestByRepoAndNumber), ctx, repoID, number)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribePullRequestEvents", reflect.TypeOf((*MockPullRequestService)(nil).SubscribePullRequestEvents), ctx, repoID)
 }
 
-// ListPullRequestInRepository mocks base method.
-func (m *MockPullRequestService) ListPullRequestInRepository(ctx context.Context, repoID string, after, before *string, first, last *int) (*model.PullRequestConnection, error) {
+// UpdatePullRequest mocks base method.
+func (m *MockPullRequestService) UpdatePullRequest(ctx context.Context, id string, baseRefName *string, closed *bool) (*model.PullRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPullRequestInRepository", ctx, repoID, after, before, first, last)
-	ret0, _ := ret[0].(*model.PullRequestConnection)
+	ret := m.ctrl.Call(m, "UpdatePullRequest", ctx, id, baseRefName, closed)
+	ret0, _ := ret[0].(*model.PullRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPullRequestInRepository indicates an expected call of ListPullRequestInRepository.
-func (mr *MockPullRequestServiceMockRecorder) ListPullRequestInRepository(ctx, repoID, after, before, first, last interface{}) *gomock.Call {
+// UpdatePullRequest indicates an expected call of UpdatePullRequest.
+func (mr *MockPullRequestServiceMockRecorder) UpdatePullRequest(ctx, id, baseRefName, closed interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequestInRepository", reflect.TypeOf((*MockPullRequestService)(nil).ListPullRequestInRepository), ctx, repoID, after, before, first, last)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequest", reflect.TypeOf((*MockPullRequestService)(nil).UpdatePullRequest), ctx, id, baseRefName, closed)
 }
 
 // MockProjectService is a mock of ProjectService interface.
@@ -660,6 +1422,21 @@ func (mr *MockProjectServiceMockRecorder) ListProjectByOwner(ctx, ownerID, after
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectByOwner", reflect.TypeOf((*MockProjectService)(nil).ListProjectByOwner), ctx, ownerID, after, before, first, last)
 }
 
+// ListProjectsByID mocks base method.
+func (m *MockProjectService) ListProjectsByID(ctx context.Context, IDs []string) ([]*model.ProjectV2, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.ProjectV2)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsByID indicates an expected call of ListProjectsByID.
+func (mr *MockProjectServiceMockRecorder) ListProjectsByID(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsByID", reflect.TypeOf((*MockProjectService)(nil).ListProjectsByID), ctx, IDs)
+}
+
 // MockProjectItemService is a mock of ProjectItemService interface.
 type MockProjectItemService struct {
 	ctrl     *gomock.Controller
@@ -713,6 +1490,20 @@ func (mr *MockProjectItemServiceMockRecorder) AddPullRequestInProjectV2(ctx, pro
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPullRequestInProjectV2", reflect.TypeOf((*MockProjectItemService)(nil).AddPullRequestInProjectV2), ctx, projectID, pullRequestID)
 }
 
+// DeleteProjectV2Item mocks base method.
+func (m *MockProjectItemService) DeleteProjectV2Item(ctx context.Context, projectID, itemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectV2Item", ctx, projectID, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectV2Item indicates an expected call of DeleteProjectV2Item.
+func (mr *MockProjectItemServiceMockRecorder) DeleteProjectV2Item(ctx, projectID, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectV2Item", reflect.TypeOf((*MockProjectItemService)(nil).DeleteProjectV2Item), ctx, projectID, itemID)
+}
+
 // GetProjectItemByID mocks base method.
 func (m *MockProjectItemService) GetProjectItemByID(ctx context.Context, id string) (*model.ProjectV2Item, error) {
 	m.ctrl.T.Helper()
@@ -772,3 +1563,433 @@ func (mr *MockProjectItemServiceMockRecorder) ListProjectItemOwnedByPullRequest(
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectItemOwnedByPullRequest", reflect.TypeOf((*MockProjectItemService)(nil).ListProjectItemOwnedByPullRequest), ctx, pullRequestID, after, before, first, last)
 }
+
+// ListProjectItemsByID mocks base method.
+func (m *MockProjectItemService) ListProjectItemsByID(ctx context.Context, IDs []string) ([]*model.ProjectV2Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectItemsByID", ctx, IDs)
+	ret0, _ := ret[0].([]*model.ProjectV2Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectItemsByID indicates an expected call of ListProjectItemsByID.
+func (mr *MockProjectItemServiceMockRecorder) ListProjectItemsByID(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectItemsByID", reflect.TypeOf((*MockProjectItemService)(nil).ListProjectItemsByID), ctx, IDs)
+}
+
+// SetProjectV2ItemArchived mocks base method.
+func (m *MockProjectItemService) SetProjectV2ItemArchived(ctx context.Context, projectID, itemID string, archived bool) (*model.ProjectV2Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectV2ItemArchived", ctx, projectID, itemID, archived)
+	ret0, _ := ret[0].(*model.ProjectV2Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProjectV2ItemArchived indicates an expected call of SetProjectV2ItemArchived.
+func (mr *MockProjectItemServiceMockRecorder) SetProjectV2ItemArchived(ctx, projectID, itemID, archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectV2ItemArchived", reflect.TypeOf((*MockProjectItemService)(nil).SetProjectV2ItemArchived), ctx, projectID, itemID, archived)
+}
+
+// SubscribeProjectItemEvents mocks base method.
+func (m *MockProjectItemService) SubscribeProjectItemEvents(ctx context.Context, projectID string) <-chan *services.ProjectItemEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeProjectItemEvents", ctx, projectID)
+	ret0, _ := ret[0].(<-chan *services.ProjectItemEvent)
+	return ret0
+}
+
+// SubscribeProjectItemEvents indicates an expected call of SubscribeProjectItemEvents.
+func (mr *MockProjectItemServiceMockRecorder) SubscribeProjectItemEvents(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeProjectItemEvents", reflect.TypeOf((*MockProjectItemService)(nil).SubscribeProjectItemEvents), ctx, projectID)
+}
+
+// MockPersonalAccessTokenService is a mock of PersonalAccessTokenService interface.
+type MockPersonalAccessTokenService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersonalAccessTokenServiceMockRecorder
+}
+
+// MockPersonalAccessTokenServiceMockRecorder is the mock recorder for MockPersonalAccessTokenService.
+type MockPersonalAccessTokenServiceMockRecorder struct {
+	mock *MockPersonalAccessTokenService
+}
+
+// NewMockPersonalAccessTokenService creates a new mock instance.
+func NewMockPersonalAccessTokenService(ctrl *gomock.Controller) *MockPersonalAccessTokenService {
+	mock := &MockPersonalAccessTokenService{ctrl: ctrl}
+	mock.recorder = &MockPersonalAccessTokenServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersonalAccessTokenService) EXPECT() *MockPersonalAccessTokenServiceMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateToken mocks base method.
+func (m *MockPersonalAccessTokenService) AuthenticateToken(ctx context.Context, token string) (*model.User, scope.Set, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateToken", ctx, token)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(scope.Set)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticateToken indicates an expected call of AuthenticateToken.
+func (mr *MockPersonalAccessTokenServiceMockRecorder) AuthenticateToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateToken", reflect.TypeOf((*MockPersonalAccessTokenService)(nil).AuthenticateToken), ctx, token)
+}
+
+// CreatePersonalAccessToken mocks base method.
+func (m *MockPersonalAccessTokenService) CreatePersonalAccessToken(ctx context.Context, ownerID, name string, scopes scope.Set, expiresAt *time.Time) (*model.PersonalAccessToken, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalAccessToken", ctx, ownerID, name, scopes, expiresAt)
+	ret0, _ := ret[0].(*model.PersonalAccessToken)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePersonalAccessToken indicates an expected call of CreatePersonalAccessToken.
+func (mr *MockPersonalAccessTokenServiceMockRecorder) CreatePersonalAccessToken(ctx, ownerID, name, scopes, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalAccessToken", reflect.TypeOf((*MockPersonalAccessTokenService)(nil).CreatePersonalAccessToken), ctx, ownerID, name, scopes, expiresAt)
+}
+
+// GetPersonalAccessToken mocks base method.
+func (m *MockPersonalAccessTokenService) GetPersonalAccessToken(ctx context.Context, ownerID, id string) (*model.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalAccessToken", ctx, ownerID, id)
+	ret0, _ := ret[0].(*model.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalAccessToken indicates an expected call of GetPersonalAccessToken.
+func (mr *MockPersonalAccessTokenServiceMockRecorder) GetPersonalAccessToken(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalAccessToken", reflect.TypeOf((*MockPersonalAccessTokenService)(nil).GetPersonalAccessToken), ctx, ownerID, id)
+}
+
+// RevokePersonalAccessToken mocks base method.
+func (m *MockPersonalAccessTokenService) RevokePersonalAccessToken(ctx context.Context, ownerID, id string) (*model.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePersonalAccessToken", ctx, ownerID, id)
+	ret0, _ := ret[0].(*model.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokePersonalAccessToken indicates an expected call of RevokePersonalAccessToken.
+func (mr *MockPersonalAccessTokenServiceMockRecorder) RevokePersonalAccessToken(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePersonalAccessToken", reflect.TypeOf((*MockPersonalAccessTokenService)(nil).RevokePersonalAccessToken), ctx, ownerID, id)
+}
+
+// MockAccountService is a mock of AccountService interface.
+type MockAccountService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountServiceMockRecorder
+}
+
+// MockAccountServiceMockRecorder is the mock recorder for MockAccountService.
+type MockAccountServiceMockRecorder struct {
+	mock *MockAccountService
+}
+
+// NewMockAccountService creates a new mock instance.
+func NewMockAccountService(ctrl *gomock.Controller) *MockAccountService {
+	mock := &MockAccountService{ctrl: ctrl}
+	mock.recorder = &MockAccountServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountService) EXPECT() *MockAccountServiceMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateSession mocks base method.
+func (m *MockAccountService) AuthenticateSession(ctx context.Context, token string) (*model.User, *auth.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateSession", ctx, token)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(*auth.Session)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticateSession indicates an expected call of AuthenticateSession.
+func (mr *MockAccountServiceMockRecorder) AuthenticateSession(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateSession", reflect.TypeOf((*MockAccountService)(nil).AuthenticateSession), ctx, token)
+}
+
+// Login mocks base method.
+func (m *MockAccountService) Login(ctx context.Context, name, password string) (*model.User, *auth.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, name, password)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(*auth.Session)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockAccountServiceMockRecorder) Login(ctx, name, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAccountService)(nil).Login), ctx, name, password)
+}
+
+// Logout mocks base method.
+func (m *MockAccountService) Logout(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAccountServiceMockRecorder) Logout(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAccountService)(nil).Logout), ctx, token)
+}
+
+// SignUp mocks base method.
+func (m *MockAccountService) SignUp(ctx context.Context, name, password string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignUp", ctx, name, password)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignUp indicates an expected call of SignUp.
+func (mr *MockAccountServiceMockRecorder) SignUp(ctx, name, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockAccountService)(nil).SignUp), ctx, name, password)
+}
+
+// MockImpersonationService is a mock of ImpersonationService interface.
+type MockImpersonationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImpersonationServiceMockRecorder
+}
+
+// MockImpersonationServiceMockRecorder is the mock recorder for MockImpersonationService.
+type MockImpersonationServiceMockRecorder struct {
+	mock *MockImpersonationService
+}
+
+// NewMockImpersonationService creates a new mock instance.
+func NewMockImpersonationService(ctrl *gomock.Controller) *MockImpersonationService {
+	mock := &MockImpersonationService{ctrl: ctrl}
+	mock.recorder = &MockImpersonationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImpersonationService) EXPECT() *MockImpersonationServiceMockRecorder {
+	return m.recorder
+}
+
+// ImpersonateUser mocks base method.
+func (m *MockImpersonationService) ImpersonateUser(ctx context.Context, name string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImpersonateUser", ctx, name)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImpersonateUser indicates an expected call of ImpersonateUser.
+func (mr *MockImpersonationServiceMockRecorder) ImpersonateUser(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImpersonateUser", reflect.TypeOf((*MockImpersonationService)(nil).ImpersonateUser), ctx, name)
+}
+
+// RecordImpersonation mocks base method.
+func (m *MockImpersonationService) RecordImpersonation(ctx context.Context, adminID, targetID, operationName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordImpersonation", ctx, adminID, targetID, operationName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordImpersonation indicates an expected call of RecordImpersonation.
+func (mr *MockImpersonationServiceMockRecorder) RecordImpersonation(ctx, adminID, targetID, operationName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordImpersonation", reflect.TypeOf((*MockImpersonationService)(nil).RecordImpersonation), ctx, adminID, targetID, operationName)
+}
+
+// MockAuditLogService is a mock of AuditLogService interface.
+type MockAuditLogService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogServiceMockRecorder
+}
+
+// MockAuditLogServiceMockRecorder is the mock recorder for MockAuditLogService.
+type MockAuditLogServiceMockRecorder struct {
+	mock *MockAuditLogService
+}
+
+// NewMockAuditLogService creates a new mock instance.
+func NewMockAuditLogService(ctrl *gomock.Controller) *MockAuditLogService {
+	mock := &MockAuditLogService{ctrl: ctrl}
+	mock.recorder = &MockAuditLogServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogService) EXPECT() *MockAuditLogServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAuditLogEntry mocks base method.
+func (m *MockAuditLogService) GetAuditLogEntry(ctx context.Context, id string) (*model.AuditLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogEntry", ctx, id)
+	ret0, _ := ret[0].(*model.AuditLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogEntry indicates an expected call of GetAuditLogEntry.
+func (mr *MockAuditLogServiceMockRecorder) GetAuditLogEntry(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogEntry", reflect.TypeOf((*MockAuditLogService)(nil).GetAuditLogEntry), ctx, id)
+}
+
+// ListAuditLog mocks base method.
+func (m *MockAuditLogService) ListAuditLog(ctx context.Context, filter *model.AuditLogFilter, after *string, first *int) (*model.AuditLogEntryConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLog", ctx, filter, after, first)
+	ret0, _ := ret[0].(*model.AuditLogEntryConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLog indicates an expected call of ListAuditLog.
+func (mr *MockAuditLogServiceMockRecorder) ListAuditLog(ctx, filter, after, first interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLog", reflect.TypeOf((*MockAuditLogService)(nil).ListAuditLog), ctx, filter, after, first)
+}
+
+// RecordAuditLog mocks base method.
+func (m *MockAuditLogService) RecordAuditLog(ctx context.Context, entry *model.AuditLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAuditLog", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAuditLog indicates an expected call of RecordAuditLog.
+func (mr *MockAuditLogServiceMockRecorder) RecordAuditLog(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuditLog", reflect.TypeOf((*MockAuditLogService)(nil).RecordAuditLog), ctx, entry)
+}
+
+// MockInteractionService is a mock of InteractionService interface.
+type MockInteractionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInteractionServiceMockRecorder
+}
+
+// MockInteractionServiceMockRecorder is the mock recorder for MockInteractionService.
+type MockInteractionServiceMockRecorder struct {
+	mock *MockInteractionService
+}
+
+// NewMockInteractionService creates a new mock instance.
+func NewMockInteractionService(ctrl *gomock.Controller) *MockInteractionService {
+	mock := &MockInteractionService{ctrl: ctrl}
+	mock.recorder = &MockInteractionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInteractionService) EXPECT() *MockInteractionServiceMockRecorder {
+	return m.recorder
+}
+
+// BlockUser mocks base method.
+func (m *MockInteractionService) BlockUser(ctx context.Context, userID string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", ctx, userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockInteractionServiceMockRecorder) BlockUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockInteractionService)(nil).BlockUser), ctx, userID)
+}
+
+// GetRepositoryInteractionAbility mocks base method.
+func (m *MockInteractionService) GetRepositoryInteractionAbility(ctx context.Context, repoID string) (*model.RepositoryInteractionAbility, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryInteractionAbility", ctx, repoID)
+	ret0, _ := ret[0].(*model.RepositoryInteractionAbility)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryInteractionAbility indicates an expected call of GetRepositoryInteractionAbility.
+func (mr *MockInteractionServiceMockRecorder) GetRepositoryInteractionAbility(ctx, repoID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryInteractionAbility", reflect.TypeOf((*MockInteractionService)(nil).GetRepositoryInteractionAbility), ctx, repoID)
+}
+
+// IsBlockedByViewer mocks base method.
+func (m *MockInteractionService) IsBlockedByViewer(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlockedByViewer", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlockedByViewer indicates an expected call of IsBlockedByViewer.
+func (mr *MockInteractionServiceMockRecorder) IsBlockedByViewer(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlockedByViewer", reflect.TypeOf((*MockInteractionService)(nil).IsBlockedByViewer), ctx, userID)
+}
+
+// SetRepositoryInteractionLimit mocks base method.
+func (m *MockInteractionService) SetRepositoryInteractionLimit(ctx context.Context, repoID string, limit model.RepositoryInteractionLimit, expiry model.RepositoryInteractionLimitExpiry) (*model.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRepositoryInteractionLimit", ctx, repoID, limit, expiry)
+	ret0, _ := ret[0].(*model.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRepositoryInteractionLimit indicates an expected call of SetRepositoryInteractionLimit.
+func (mr *MockInteractionServiceMockRecorder) SetRepositoryInteractionLimit(ctx, repoID, limit, expiry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRepositoryInteractionLimit", reflect.TypeOf((*MockInteractionService)(nil).SetRepositoryInteractionLimit), ctx, repoID, limit, expiry)
+}
+
+// UnblockUser mocks base method.
+func (m *MockInteractionService) UnblockUser(ctx context.Context, userID string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", ctx, userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockInteractionServiceMockRecorder) UnblockUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockInteractionService)(nil).UnblockUser), ctx, userID)
+}
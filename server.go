@@ -6,16 +6,28 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/saki-engineering/graphql-sample/graph"
+	"github.com/saki-engineering/graphql-sample/graph/apq"
+	"github.com/saki-engineering/graphql-sample/graph/audit"
+	"github.com/saki-engineering/graphql-sample/graph/impersonation"
 	"github.com/saki-engineering/graphql-sample/graph/services"
+	"github.com/saki-engineering/graphql-sample/graph/sse"
+	"github.com/saki-engineering/graphql-sample/graph/trusteddocs"
 	"github.com/saki-engineering/graphql-sample/internal"
 	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+	"github.com/saki-engineering/graphql-sample/middlewares/querylimit"
+	"github.com/saki-engineering/graphql-sample/middlewares/ratelimit"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
@@ -29,23 +41,88 @@ func main() {
 	if port == "" {
 		port = defaultPort
 	}
+	maxPageSize := envInt("MAX_PAGE_SIZE", services.DefaultMaxPageSize)
+	complexityLimit := envInt("COMPLEXITY_LIMIT", graph.DefaultComplexityLimit)
+	// 0を指定した制限は無効になる
+	queryLimits := querylimit.Limits{
+		MaxDepth:      envInt("MAX_QUERY_DEPTH", querylimit.DefaultLimits.MaxDepth),
+		MaxAliases:    envInt("MAX_QUERY_ALIASES", querylimit.DefaultLimits.MaxAliases),
+		MaxRootFields: envInt("MAX_QUERY_ROOT_FIELDS", querylimit.DefaultLimits.MaxRootFields),
+		MaxTokens:     envInt("MAX_QUERY_TOKENS", querylimit.DefaultLimits.MaxTokens),
+		MaxBodyBytes:  int64(envInt("MAX_BODY_BYTES", int(querylimit.DefaultLimits.MaxBodyBytes))),
+	}
 
-	db, err := sql.Open("sqlite3", fmt.Sprintf("%s?_foreign_keys=on", dbFile))
+	// トランザクションは開始時に書き込みロックを取り、連番の読み取りと追加の間に他の書き込みを挟ませない
+	db, err := sql.Open("sqlite3", fmt.Sprintf("%s?_foreign_keys=on&_txlock=immediate", dbFile))
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer db.Close()
 
-	service := services.New(db)
-	srv := handler.NewDefaultServer(internal.NewExecutableSchema(internal.Config{
-		Resolvers: &graph.Resolver{
-			Srv:     service,
-			Loaders: graph.NewLoaders(service),
-		},
+	service := services.New(db, services.WithMaxPageSize(maxPageSize))
+	authenticator, err := newAuthenticator(service)
+	if err != nil {
+		log.Fatal(err)
+	}
+	resolver := &graph.Resolver{Srv: service, MaxPageSize: maxPageSize}
+	srv := handler.New(internal.NewExecutableSchema(internal.Config{
+		Resolvers:  resolver,
 		Directives: graph.Directive,
-		Complexity: graph.ComplexityConfig(),
+		Complexity: graph.ComplexityConfig(maxPageSize),
 	}))
-	srv.Use(extension.FixedComplexityLimit(10))
+	// subscriptionはwebsocketで受け付け、connection_initで認証する
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              auth.WebsocketInitFunc(authenticator),
+	})
+	srv.AddTransport(transport.Options{})
+	// text/event-streamを要求するGET・POSTを通常のGET・POSTより先に拾う
+	srv.AddTransport(sse.Transport{})
+	// @deferを含むクエリの結果をmultipart/mixedで分割して返す
+	// @streamはgqlgenの実行器が対応していないので、スキーマに宣言していない
+	srv.AddTransport(transport.MultipartMixed{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.Use(extension.Introspection{})
+	// マニフェストが指定されている場合は、登録済みのオペレーションだけを受け付ける
+	if path := os.Getenv("TRUSTED_DOCUMENTS"); path != "" {
+		mode, err := trusteddocs.ParseMode(os.Getenv("TRUSTED_DOCUMENTS_MODE"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		trusted, err := trusteddocs.Load(path, mode)
+		if err != nil {
+			log.Fatal(err)
+		}
+		srv.Use(trusted)
+	}
+	// APQで登録されたクエリはDBに保存し、再起動後も使えるようにする
+	persistedQueries := apq.NewStore(db)
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: persistedQueries,
+	})
+	// 深すぎる・大きすぎるクエリはコストを計算する前に拒否する
+	// ID・ハッシュだけのリクエストも展開後のクエリで検査するため、上の2つより後に登録する
+	queryLimit := querylimit.New(queryLimits)
+	srv.Use(queryLimit)
+	srv.SetErrorPresenter(graph.ErrorPresenter)
+	srv.Use(extension.FixedComplexityLimit(complexityLimit))
+	srv.AroundResponses(graph.ReportComplexity)
+	// Loaderのキャッシュはオペレーションごとに作り直す
+	srv.AroundOperations(resolver.WithLoaders)
+	// 全てのmutationを、実行者・変数・対象のノード・結果と一緒に記録する
+	srv.AroundOperations(audit.New(service).AroundOperations)
+	// コストはユーザーごと(未認証ならIPごと)の1時間あたりの予算から差し引く
+	limiter := ratelimit.New(ratelimit.DefaultUserLimit, ratelimit.DefaultAnonymousLimit, ratelimit.DefaultWindow)
+	srv.Use(limiter)
+	// 管理者のなりすましは全て記録し、IMPERSONATION_ALLOW_MUTATIONS=trueでない限りmutationを拒否する
+	var impersonationOpts []impersonation.Option
+	if os.Getenv("IMPERSONATION_ALLOW_MUTATIONS") == "true" {
+		impersonationOpts = append(impersonationOpts, impersonation.AllowMutations())
+	}
+	srv.Use(impersonation.New(service, impersonationOpts...))
 	// srv.AroundRootFields(func(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
 	// 	log.Println("before RootResolver")
 	// 	res := next(ctx)
@@ -78,8 +155,59 @@ func main() {
 	boil.DebugMode = true
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", auth.AuthMiddleware(srv))
+	// ログインで発行したセッションのCookieでも認証するので、playgroundはトークンなしで使える
+	authOpts := []auth.Option{auth.WithSessions(service), auth.WithImpersonation(service)}
+	http.Handle("/query", queryLimit.BodyLimit(limiter.Middleware(auth.AuthMiddleware(authenticator, srv, authOpts...))))
+	// 管理用のエンドポイントも/queryと同じ方法で認証し、サイトの管理者だけに見せる
+	http.Handle("/admin/persisted-queries", auth.AuthMiddleware(authenticator, persistedQueries.AdminHandler(), authOpts...))
 
 	log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
 	log.Fatal(http.ListenAndServe(":"+port, nil))
 }
+
+// envInt は、環境変数nameを整数として読む
+// 設定されていない場合はdefを返す
+func envInt(name string, def int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		log.Fatalf("%s: %v", name, err)
+	}
+	return n
+}
+
+// newAuthenticator は、AUTH_MODEに応じてトークンの検証方法を選ぶ
+//   - token(デフォルト): DBに保存した個人用アクセストークン
+//   - jwt: JWT_JWKS_FILEの鍵で検証するIdPのJWT
+//   - both: JWTの形をしたものはjwt、それ以外はtoken
+func newAuthenticator(service services.Services) (auth.Authenticator, error) {
+	mode := os.Getenv("AUTH_MODE")
+	switch mode {
+	case "", "token":
+		return service, nil
+	case "jwt", "both":
+	default:
+		return nil, fmt.Errorf("unknown AUTH_MODE %q", mode)
+	}
+	// 他のIdPや他のサービス向けに発行されたトークンを受け付けないよう、issとaudの検証は必須にする
+	if os.Getenv("JWT_ISSUER") == "" || os.Getenv("JWT_AUDIENCE") == "" {
+		return nil, fmt.Errorf("JWT_ISSUER and JWT_AUDIENCE are required when AUTH_MODE=%s", mode)
+	}
+
+	jwt, err := auth.NewJWTAuthenticator(auth.JWTConfig{
+		JWKSFile:  os.Getenv("JWT_JWKS_FILE"),
+		Issuer:    os.Getenv("JWT_ISSUER"),
+		Audience:  os.Getenv("JWT_AUDIENCE"),
+		UserClaim: os.Getenv("JWT_USER_CLAIM"),
+	}, service)
+	if err != nil {
+		return nil, err
+	}
+	if mode == "jwt" {
+		return jwt, nil
+	}
+	return auth.Combine(service, jwt), nil
+}
@@ -1,23 +1,39 @@
 package main_test
 
 import (
+	"bufio"
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"flag"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/saki-engineering/graphql-sample/graph"
+	"github.com/saki-engineering/graphql-sample/graph/apperrors"
 	"github.com/saki-engineering/graphql-sample/graph/model"
+	"github.com/saki-engineering/graphql-sample/graph/scope"
+	srvs "github.com/saki-engineering/graphql-sample/graph/services"
+	"github.com/saki-engineering/graphql-sample/graph/sse"
 	"github.com/saki-engineering/graphql-sample/internal"
+	"github.com/saki-engineering/graphql-sample/middlewares/auth"
+	"github.com/saki-engineering/graphql-sample/middlewares/querylimit"
+	"github.com/saki-engineering/graphql-sample/middlewares/ratelimit"
 	"github.com/saki-engineering/graphql-sample/mock/services"
 
+	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/golang/mock/gomock"
 	"github.com/tenntenn/golden"
 )
@@ -80,12 +96,31 @@ func TestNodeRepository(t *testing.T) {
 		Name: "hsaki",
 	}, nil)
 
-	srv := httptest.NewServer(
-		handler.NewDefaultServer(internal.NewExecutableSchema(internal.Config{Resolvers: &graph.Resolver{
+	got := postGolden(t, sm)
+	if diff := golden.Check(t, flagUpdate, goldenDir, t.Name()+"Out.json", got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// postGolden は、<テスト名>In.gpl.golden のクエリをサーバーに投げてレスポンスを返す
+func postGolden(t *testing.T, sm *services.MockServices) string {
+	t.Helper()
+	return postGoldenAs(t, sm, "")
+}
+
+// postGoldenAs は、tokenで認証した上でpostGoldenと同じことをする
+func postGoldenAs(t *testing.T, sm *services.MockServices, token string) string {
+	t.Helper()
+
+	h := handler.NewDefaultServer(internal.NewExecutableSchema(internal.Config{
+		Resolvers: &graph.Resolver{
 			Srv:     sm,
 			Loaders: graph.NewLoaders(sm),
-		}})),
-	)
+		},
+		Directives: graph.Directive,
+	}))
+	h.SetErrorPresenter(graph.ErrorPresenter)
+	srv := httptest.NewServer(auth.AuthMiddleware(sm, h))
 	t.Cleanup(func() { srv.Close() })
 
 	reqBody := getRequestBody(t, goldenDir, t.Name()+"In.gpl")
@@ -94,14 +129,1160 @@ func TestNodeRepository(t *testing.T) {
 		t.Fatal("error new request", err)
 	}
 	req.Header.Add("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Add("Authorization", "Bearer "+token)
+	}
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
 		t.Fatal("error request", err)
 	}
 	t.Cleanup(func() { res.Body.Close() })
 
-	got := getResponseBody(t, res)
+	return getResponseBody(t, res)
+}
+
+func TestNodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 型ごとに1回ずつ、まとめて呼ばれることを確かめる
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().ListUsersByID(gomock.Any(), []string{"U_1"}).Return([]*model.User{
+		{ID: "U_1", Name: "hsaki"},
+	}, nil)
+	sm.EXPECT().ListReposByID(gomock.Any(), []string{"REPO_1"}).Return([]*model.Repository{
+		{ID: "REPO_1", Owner: &model.User{ID: "U_1"}, Name: "repo1"},
+	}, nil)
+	sm.EXPECT().ListIssuesByID(gomock.Any(), []string{"ISSUE_1", "ISSUE_99", "ISSUE_2"}).Return([]*model.Issue{
+		{ID: "ISSUE_2", Title: "Second Issue"},
+		{ID: "ISSUE_1", Title: "First Issue"},
+	}, nil)
+
+	got := postGolden(t, sm)
+	if diff := golden.Check(t, flagUpdate, goldenDir, t.Name()+"Out.json", got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNodeProjectV2Item(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	itemID := "PJI_4b3c1f5e-8a0d-4a8e-9f6b-2d7c9e1a0b3c"
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetProjectItemByID(gomock.Any(), itemID).Return(&model.ProjectV2Item{
+		ID:      itemID,
+		Project: &model.ProjectV2{ID: "PJ_1"},
+		Content: &model.Issue{ID: "ISSUE_1"},
+	}, nil).Times(2)
+	sm.EXPECT().GetIssueByID(gomock.Any(), "ISSUE_1").Return(&model.Issue{
+		ID:    "ISSUE_1",
+		Title: "First Issue",
+	}, nil)
+
+	got := postGolden(t, sm)
+	if diff := golden.Check(t, flagUpdate, goldenDir, t.Name()+"Out.json", got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNodeInvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// サービスは呼ばれない
+	sm := services.NewMockServices(ctrl)
+
+	got := postGolden(t, sm)
 	if diff := golden.Check(t, flagUpdate, goldenDir, t.Name()+"Out.json", got); diff != "" {
 		t.Errorf("mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestRepositoryNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 見つからない場合はエラーではなくnullになる
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetUserByName(gomock.Any(), "hsaki").Return(&model.User{ID: "U_1", Name: "hsaki"}, nil)
+	sm.EXPECT().GetRepoByFullName(gomock.Any(), "U_1", "unknown").Return(nil, sql.ErrNoRows)
+
+	got := postGolden(t, sm)
+	if diff := golden.Check(t, flagUpdate, goldenDir, t.Name()+"Out.json", got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestInternalErrorMasked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetRepoByID(gomock.Any(), "REPO_1").Return(nil, errors.New("sql: database is locked"))
+
+	// 相関IDが毎回変わるのでgoldenファイルは使わない
+	var res struct {
+		Errors []struct {
+			Message    string
+			Extensions map[string]string
+		}
+	}
+	if err := json.Unmarshal([]byte(postGolden(t, sm)), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Errors) != 1 {
+		t.Fatalf("want 1 error, but got %d", len(res.Errors))
+	}
+	gqlErr := res.Errors[0]
+	if strings.Contains(gqlErr.Message, "database is locked") {
+		t.Errorf("internal error leaked: %s", gqlErr.Message)
+	}
+	if gqlErr.Extensions["code"] != "INTERNAL" {
+		t.Errorf("want code INTERNAL, but got %s", gqlErr.Extensions["code"])
+	}
+	if gqlErr.Extensions["correlationId"] == "" {
+		t.Error("correlationId is missing")
+	}
+}
+
+func TestAddProjectV2ItemByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	itemID := "PJI_4b3c1f5e-8a0d-4a8e-9f6b-2d7c9e1a0b3c"
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetProjectByID(gomock.Any(), "PJ_1").Return(&model.ProjectV2{ID: "PJ_1"}, nil)
+	sm.EXPECT().GetIssueByID(gomock.Any(), "ISSUE_1").Return(&model.Issue{ID: "ISSUE_1"}, nil)
+	sm.EXPECT().AddIssueInProjectV2(gomock.Any(), "PJ_1", "ISSUE_1").Return(&model.ProjectV2Item{
+		ID:      itemID,
+		Project: &model.ProjectV2{ID: "PJ_1"},
+		Content: &model.Issue{ID: "ISSUE_1"},
+	}, nil)
+	expectToken(sm, "pat_hsaki", &model.User{ID: "U_1", Name: "hsaki"}, scope.Project)
+
+	got := postGoldenAs(t, sm, "pat_hsaki")
+	if diff := golden.Check(t, flagUpdate, goldenDir, t.Name()+"Out.json", got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAddProjectV2ItemByIDUserErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 不正なIDや存在しないIDは、トップレベルのエラーではなくuserErrorsとして返る
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetProjectByID(gomock.Any(), "PJ_99").Return(nil, sql.ErrNoRows)
+	expectToken(sm, "pat_hsaki", &model.User{ID: "U_1", Name: "hsaki"}, scope.Project)
+
+	got := postGoldenAs(t, sm, "pat_hsaki")
+	if diff := golden.Check(t, flagUpdate, goldenDir, t.Name()+"Out.json", got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestInteractionMutationsInvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 型の違うIDはuserErrorsとして返り、サービスは呼ばれない
+	sm := services.NewMockServices(ctrl)
+	expectToken(sm, "pat_hsaki", &model.User{ID: "U_1", Name: "hsaki"}, scope.User, scope.RepoWrite)
+
+	got := postGoldenAs(t, sm, "pat_hsaki")
+	if diff := golden.Check(t, flagUpdate, goldenDir, t.Name()+"Out.json", got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRequiresScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 読み取り専用のトークンではプロジェクトを変更できず、サービスも呼ばれない
+	sm := services.NewMockServices(ctrl)
+	expectToken(sm, "pat_ci", &model.User{ID: "U_1", Name: "hsaki"}, scope.RepoRead)
+
+	got := postGoldenAs(t, sm, "pat_ci")
+	if diff := golden.Check(t, flagUpdate, goldenDir, t.Name()+"Out.json", got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAuditLogFilterValidatesIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 壊れたIDや型の違うIDは、空の結果ではなくバリデーションエラーにする。サービスは呼ばれない
+	sm := services.NewMockServices(ctrl)
+	expectToken(sm, "pat_hsaki", &model.User{ID: "U_1", Name: "hsaki", IsSiteAdmin: true}, scope.All...).AnyTimes()
+
+	h := handler.New(internal.NewExecutableSchema(internal.Config{
+		Resolvers: &graph.Resolver{
+			Srv:     sm,
+			Loaders: graph.NewLoaders(sm),
+		},
+		Directives: graph.Directive,
+	}))
+	h.AddTransport(transport.POST{})
+	h.SetErrorPresenter(graph.ErrorPresenter)
+	srv := httptest.NewServer(auth.AuthMiddleware(sm, h))
+	t.Cleanup(func() { srv.Close() })
+
+	tests := []struct {
+		name   string
+		filter string
+		want   string
+	}{
+		{name: "malformed actor", filter: `actorId: \"hsaki\"`, want: `invalid ID \"hsaki\"`},
+		{name: "repository as actor", filter: `actorId: \"REPO_1\"`, want: `unexpected type \"REPO\"`},
+		{name: "unknown node type", filter: `nodeId: \"NOPE_1\"`, want: `unknown type \"NOPE\"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqBody := strings.NewReader(`{"query": "{ auditLog(filter: {` + tt.filter + `}, first: 10) { totalCount } }"}`)
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, reqBody)
+			if err != nil {
+				t.Fatal("error new request", err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			req.Header.Add("Authorization", "Bearer pat_hsaki")
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal("error request", err)
+			}
+			t.Cleanup(func() { res.Body.Close() })
+
+			body := getResponseBody(t, res)
+			for _, want := range []string{tt.want, `"VALIDATION"`} {
+				if !strings.Contains(body, want) {
+					t.Errorf("response does not contain %s:\n%s", want, body)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthDirectiveChecksViewerOnlyPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 管理者でない場合は@authで拒否され、サービスは呼ばれない
+	sm := services.NewMockServices(ctrl)
+	expectToken(sm, "pat_octocat", &model.User{ID: "U_2", Name: "octocat"}, scope.All...)
+	expectToken(sm, "pat_hsaki", &model.User{ID: "U_1", Name: "hsaki", IsSiteAdmin: true}, scope.All...)
+	sm.EXPECT().ListAuditLog(gomock.Any(), nil, nil, gomock.Any()).Return(&model.AuditLogEntryConnection{PageInfo: &model.PageInfo{}}, nil)
+
+	h := handler.New(internal.NewExecutableSchema(internal.Config{
+		Resolvers: &graph.Resolver{
+			Srv:     sm,
+			Loaders: graph.NewLoaders(sm),
+		},
+		Directives: graph.Directive,
+	}))
+	h.AddTransport(transport.POST{})
+	h.SetErrorPresenter(graph.ErrorPresenter)
+	srv := httptest.NewServer(auth.AuthMiddleware(sm, h))
+	t.Cleanup(func() { srv.Close() })
+
+	post := func(token string) string {
+		t.Helper()
+		reqBody := strings.NewReader(`{"query": "{ auditLog(first: 10) { totalCount } }"}`)
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, reqBody)
+		if err != nil {
+			t.Fatal("error new request", err)
+		}
+		req.Header.Add("Content-Type", "application/json")
+		req.Header.Add("Authorization", "Bearer "+token)
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal("error request", err)
+		}
+		t.Cleanup(func() { res.Body.Close() })
+		return getResponseBody(t, res)
+	}
+
+	if got := post("pat_octocat"); !strings.Contains(got, "FORBIDDEN") {
+		t.Errorf("want FORBIDDEN, but got %s", got)
+	}
+	if got := post("pat_hsaki"); !strings.Contains(got, `"totalCount": 0`) {
+		t.Errorf("unexpected response: %s", got)
+	}
+}
+
+// expectToken は、tokenがscopesを持つuserのトークンとして認証されるようにする
+func expectToken(sm *services.MockServices, token string, user *model.User, scopes ...scope.Scope) *gomock.Call {
+	return sm.EXPECT().AuthenticateToken(gomock.Any(), token).Return(user, scope.Set(scopes), nil)
+}
+
+// newWebsocketClient は、server.goと同じくconnection_initで認証するwebsocketを受け付けるクライアントを返す
+func newWebsocketClient(t *testing.T, sm *services.MockServices) *client.Client {
+	t.Helper()
+
+	h := handler.New(internal.NewExecutableSchema(internal.Config{Resolvers: &graph.Resolver{
+		Srv:     sm,
+		Loaders: graph.NewLoaders(sm),
+	}}))
+	h.AddTransport(transport.Websocket{InitFunc: auth.WebsocketInitFunc(sm)})
+	h.SetErrorPresenter(graph.ErrorPresenter)
+	return client.New(h)
+}
+
+func TestSubscriptionProjectV2ItemAdded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	itemID := "PJI_4b3c1f5e-8a0d-4a8e-9f6b-2d7c9e1a0b3c"
+	events := make(chan *srvs.ProjectItemEvent, 2)
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetProjectByID(gomock.Any(), "PJ_1").Return(&model.ProjectV2{ID: "PJ_1"}, nil)
+	sm.EXPECT().SubscribeProjectItemEvents(gomock.Any(), "PJ_1").Return(events)
+	expectToken(sm, "pat_hsaki", &model.User{ID: "U_1", Name: "hsaki"})
+
+	// 種類の違うイベントは流れない
+	events <- &srvs.ProjectItemEvent{Type: srvs.ProjectItemRemoved, Item: &model.ProjectV2Item{ID: "PJI_removed"}}
+	events <- &srvs.ProjectItemEvent{Type: srvs.ProjectItemAdded, Item: &model.ProjectV2Item{ID: itemID, IsArchived: false}}
+
+	c := newWebsocketClient(t, sm)
+	sub := c.WebsocketWithPayload(`subscription { projectV2ItemAdded(projectId: "PJ_1") { id isArchived } }`,
+		map[string]interface{}{"Authorization": "Bearer pat_hsaki"})
+	t.Cleanup(func() { sub.Close() })
+
+	var res struct {
+		ProjectV2ItemAdded struct {
+			ID         string
+			IsArchived bool
+		}
+	}
+	if err := sub.Next(&res); err != nil {
+		t.Fatal(err)
+	}
+	if res.ProjectV2ItemAdded.ID != itemID {
+		t.Errorf("want %s, but got %s", itemID, res.ProjectV2ItemAdded.ID)
+	}
+}
+
+func TestSubscriptionInvalidToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 認証に失敗した場合は、トークンの検証以外のサービスは呼ばれない
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().AuthenticateToken(gomock.Any(), "invalid").Return(nil, nil, apperrors.New(apperrors.Unauthenticated, "invalid token"))
+
+	c := newWebsocketClient(t, sm)
+	sub := c.WebsocketWithPayload(`subscription { projectV2ItemAdded(projectId: "PJ_1") { id } }`,
+		map[string]interface{}{"Authorization": "invalid"})
+	t.Cleanup(func() { sub.Close() })
+
+	var res struct{}
+	if err := sub.Next(&res); err == nil {
+		t.Error("want error, but got nil")
+	}
+}
+
+func TestSubscriptionIssueChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	events := make(chan *srvs.IssueEvent, 2)
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetRepoByID(gomock.Any(), "REPO_1").Return(&model.Repository{ID: "REPO_1"}, nil)
+	sm.EXPECT().SubscribeIssueEvents(gomock.Any(), "REPO_1").Return(events)
+	// 購読を始めた後に読めなくなったIssueのイベントは流れない
+	sm.EXPECT().GetIssueByID(gomock.Any(), "ISSUE_hidden").Return(nil, apperrors.New(apperrors.NotFound, "issue not found"))
+	sm.EXPECT().GetIssueByID(gomock.Any(), "ISSUE_1").Return(&model.Issue{ID: "ISSUE_1", Title: "Renamed"}, nil)
+	events <- &srvs.IssueEvent{
+		Action:        srvs.ChangeUpdated,
+		ChangedFields: []string{"title"},
+		Issue:         &model.Issue{ID: "ISSUE_hidden", Title: "Secret"},
+	}
+	events <- &srvs.IssueEvent{
+		Action:        srvs.ChangeUpdated,
+		ChangedFields: []string{"title"},
+		Issue:         &model.Issue{ID: "ISSUE_1", Title: "Renamed"},
+	}
+
+	c := newWebsocketClient(t, sm)
+	sub := c.Websocket(`subscription { issueChanged(repositoryId: "REPO_1") { action changedFields issue { id title } } }`)
+	t.Cleanup(func() { sub.Close() })
+
+	var res struct {
+		IssueChanged struct {
+			Action        string
+			ChangedFields []string
+			Issue         struct {
+				ID    string
+				Title string
+			}
+		}
+	}
+	if err := sub.Next(&res); err != nil {
+		t.Fatal(err)
+	}
+	got := res.IssueChanged
+	if got.Action != "UPDATED" || len(got.ChangedFields) != 1 || got.ChangedFields[0] != "title" || got.Issue.Title != "Renamed" {
+		t.Errorf("unexpected event: %+v", got)
+	}
+}
+
+func TestSubscriptionSSE(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	itemID := "PJI_4b3c1f5e-8a0d-4a8e-9f6b-2d7c9e1a0b3c"
+	events := make(chan *srvs.ProjectItemEvent, 1)
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetProjectByID(gomock.Any(), "PJ_1").Return(&model.ProjectV2{ID: "PJ_1"}, nil)
+	sm.EXPECT().SubscribeProjectItemEvents(gomock.Any(), "PJ_1").Return(events)
+	events <- &srvs.ProjectItemEvent{Type: srvs.ProjectItemAdded, Item: &model.ProjectV2Item{ID: itemID}}
+	// チャネルが閉じられると購読が終わり、completeイベントが送られる
+	close(events)
+
+	h := handler.New(internal.NewExecutableSchema(internal.Config{Resolvers: &graph.Resolver{
+		Srv:     sm,
+		Loaders: graph.NewLoaders(sm),
+	}}))
+	h.AddTransport(sse.Transport{})
+	h.AddTransport(transport.POST{})
+	srv := httptest.NewServer(h)
+	t.Cleanup(func() { srv.Close() })
+
+	reqBody := strings.NewReader(`{"query": "subscription { projectV2ItemAdded(projectId: \"PJ_1\") { id } }"}`)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, reqBody)
+	if err != nil {
+		t.Fatal("error new request", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "text/event-stream")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal("error request", err)
+	}
+	t.Cleanup(func() { res.Body.Close() })
+
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("want text/event-stream, but got %s", ct)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ":\n\n" +
+		`event: next` + "\n" + `data: {"data":{"projectV2ItemAdded":{"id":"` + itemID + `"}}}` + "\n\n" +
+		"event: complete\ndata:\n\n"
+	if string(body) != want {
+		t.Errorf("want %q, but got %q", want, string(body))
+	}
+}
+
+func TestSubscriptionSSEOverGET(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	itemID := "PJI_4b3c1f5e-8a0d-4a8e-9f6b-2d7c9e1a0b3c"
+	events := make(chan *srvs.ProjectItemEvent, 1)
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetProjectByID(gomock.Any(), "PJ_1").Return(&model.ProjectV2{ID: "PJ_1"}, nil)
+	sm.EXPECT().SubscribeProjectItemEvents(gomock.Any(), "PJ_1").Return(events)
+	events <- &srvs.ProjectItemEvent{Type: srvs.ProjectItemAdded, Item: &model.ProjectV2Item{ID: itemID}}
+	close(events)
+
+	h := handler.New(internal.NewExecutableSchema(internal.Config{Resolvers: &graph.Resolver{
+		Srv:     sm,
+		Loaders: graph.NewLoaders(sm),
+	}}))
+	h.AddTransport(sse.Transport{})
+	h.AddTransport(transport.GET{})
+	srv := httptest.NewServer(h)
+	t.Cleanup(func() { srv.Close() })
+
+	// distinct connectionsモードでは、オペレーションをクエリパラメータで送れる
+	params := url.Values{}
+	params.Set("query", `subscription Added($id: ID!) { projectV2ItemAdded(projectId: $id) { id } }`)
+	params.Set("variables", `{"id": "PJ_1"}`)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"?"+params.Encode(), nil)
+	if err != nil {
+		t.Fatal("error new request", err)
+	}
+	req.Header.Add("Accept", "text/event-stream")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal("error request", err)
+	}
+	t.Cleanup(func() { res.Body.Close() })
+
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("want text/event-stream, but got %s", ct)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ":\n\n" +
+		`event: next` + "\n" + `data: {"data":{"projectV2ItemAdded":{"id":"` + itemID + `"}}}` + "\n\n" +
+		"event: complete\ndata:\n\n"
+	if string(body) != want {
+		t.Errorf("want %q, but got %q", want, string(body))
+	}
+}
+
+func TestSubscriptionSSERejectsBeforeStreaming(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 実行できないオペレーションは、event streamではなくJSONのエラーで返し、サービスも呼ばない
+	sm := services.NewMockServices(ctrl)
+	h := handler.New(internal.NewExecutableSchema(internal.Config{
+		Resolvers: &graph.Resolver{
+			Srv:     sm,
+			Loaders: graph.NewLoaders(sm),
+		},
+		Directives: graph.Directive,
+	}))
+	h.AddTransport(sse.Transport{})
+	h.Use(extension.FixedComplexityLimit(3))
+	srv := httptest.NewServer(h)
+	t.Cleanup(func() { srv.Close() })
+
+	tests := []struct {
+		name       string
+		method     string
+		query      string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "parse error",
+			method:     http.MethodPost,
+			query:      `subscription {`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `"GRAPHQL_PARSE_FAILED"`,
+		},
+		{
+			name:       "validation error",
+			method:     http.MethodGet,
+			query:      `subscription { unknownField }`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `"GRAPHQL_VALIDATION_FAILED"`,
+		},
+		{
+			name:       "complexity limit",
+			method:     http.MethodPost,
+			query:      `subscription { projectV2ItemAdded(projectId: "PJ_1") { id project { id title } } }`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `"COMPLEXITY_LIMIT_EXCEEDED"`,
+		},
+		{
+			// GETはCSRFの検査を通らないので、mutationは受け付けない
+			name:       "mutation over GET",
+			method:     http.MethodGet,
+			query:      `mutation { logout(input: {}) { clientMutationId } }`,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   `mutations are not allowed over GET`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req *http.Request
+			var err error
+			if tt.method == http.MethodGet {
+				req, err = http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"?"+url.Values{"query": {tt.query}}.Encode(), nil)
+			} else {
+				b, _ := json.Marshal(map[string]string{"query": tt.query})
+				req, err = http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, bytes.NewReader(b))
+				req.Header.Add("Content-Type", "application/json")
+			}
+			if err != nil {
+				t.Fatal("error new request", err)
+			}
+			req.Header.Add("Accept", "text/event-stream")
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal("error request", err)
+			}
+			t.Cleanup(func() { res.Body.Close() })
+
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("want status %d, but got %d", tt.wantStatus, res.StatusCode)
+			}
+			if ct := res.Header.Get("Content-Type"); strings.HasPrefix(ct, "text/event-stream") {
+				t.Errorf("want a JSON error, but got %s", ct)
+			}
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(body), tt.wantBody) {
+				t.Errorf("response does not contain %s:\n%s", tt.wantBody, body)
+			}
+		})
+	}
+}
+
+func TestDeferMultipartMixed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetRepoByID(gomock.Any(), "REPO_1").Return(&model.Repository{
+		ID:    "REPO_1",
+		Owner: &model.User{ID: "U_1"},
+		Name:  "repo1",
+	}, nil)
+	sm.EXPECT().GetUserByID(gomock.Any(), "U_1").Return(&model.User{ID: "U_1", Name: "hsaki"}, nil)
+
+	h := handler.New(internal.NewExecutableSchema(internal.Config{
+		Resolvers: &graph.Resolver{
+			Srv:     sm,
+			Loaders: graph.NewLoaders(sm),
+		},
+		Directives: graph.Directive,
+	}))
+	h.AddTransport(transport.MultipartMixed{})
+	h.AddTransport(transport.POST{})
+	srv := httptest.NewServer(h)
+	t.Cleanup(func() { srv.Close() })
+
+	reqBody := strings.NewReader(`{"query": "{ node(id: \"REPO_1\") { id ... on Repository @defer(label: \"owner\") { owner { name } } } }"}`)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, reqBody)
+	if err != nil {
+		t.Fatal("error new request", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", `multipart/mixed;deferSpec=20220824`)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal("error request", err)
+	}
+	t.Cleanup(func() { res.Body.Close() })
+
+	if ct := res.Header.Get("Content-Type"); !strings.HasPrefix(ct, "multipart/mixed") {
+		t.Errorf("want multipart/mixed, but got %s", ct)
+	}
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := string(raw)
+	// 最初のパートでは@deferの部分はまだnullで、後続のパートで届く
+	for _, want := range []string{
+		`{"data":{"node":{"id":"REPO_1","owner":null}},"hasNext":true}`,
+		`"label":"owner"`,
+		`"data":{"owner":{"name":"hsaki"}}`,
+		`"hasNext":false`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("response does not contain %s:\n%s", want, body)
+		}
+	}
+}
+
+func TestDeferNestedConnection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 遅いprojectItemsは、最初のパートがクライアントに届くまで解決を止めておく
+	release := make(chan struct{})
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetUserByName(gomock.Any(), "hsaki").Return(&model.User{ID: "U_1", Name: "hsaki"}, nil)
+	sm.EXPECT().GetRepoByFullName(gomock.Any(), "U_1", "repo1").Return(&model.Repository{ID: "REPO_1", Name: "repo1"}, nil)
+	sm.EXPECT().ListIssueInRepository(gomock.Any(), "REPO_1", nil, nil, gomock.Any(), nil).Return(&model.IssueConnection{
+		Nodes:    []*model.Issue{{ID: "ISSUE_1", Title: "first"}},
+		PageInfo: &model.PageInfo{},
+	}, nil)
+	sm.EXPECT().ListProjectItemOwnedByIssue(gomock.Any(), "ISSUE_1", nil, nil, gomock.Any(), nil).DoAndReturn(
+		func(ctx context.Context, issueID string, after, before *string, first, last *int) (*model.ProjectV2ItemConnection, error) {
+			// 遅延されていなければ最初のパートに含まれてしまい、下の検査で失敗する
+			select {
+			case <-release:
+			case <-time.After(5 * time.Second):
+			}
+			return &model.ProjectV2ItemConnection{
+				Nodes:    []*model.ProjectV2Item{{ID: "PVTI_1"}},
+				PageInfo: &model.PageInfo{},
+			}, nil
+		})
+
+	h := handler.New(internal.NewExecutableSchema(internal.Config{
+		Resolvers: &graph.Resolver{
+			Srv:     sm,
+			Loaders: graph.NewLoaders(sm),
+		},
+		Directives: graph.Directive,
+	}))
+	h.AddTransport(transport.MultipartMixed{})
+	h.AddTransport(transport.POST{})
+	srv := httptest.NewServer(h)
+	t.Cleanup(func() { srv.Close() })
+
+	reqBody := strings.NewReader(`{"query": "{ repository(owner: \"hsaki\", name: \"repo1\") { issues(first: 10) { nodes { title ... @defer(label: \"items\") { projectItems(first: 10) { nodes { id } } } } } } }"}`)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, reqBody)
+	if err != nil {
+		t.Fatal("error new request", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", `multipart/mixed;deferSpec=20220824`)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal("error request", err)
+	}
+	t.Cleanup(func() { res.Body.Close() })
+
+	// Issueの一覧は、projectItemsを待たずに最初のパートで届く
+	r := bufio.NewReader(res.Body)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatal("initial part did not arrive:", err)
+		}
+		if strings.Contains(line, `"hasNext":true`) {
+			want := `{"data":{"repository":{"issues":{"nodes":[{"title":"first","projectItems":null}]}}},"hasNext":true}`
+			if !strings.Contains(line, want) {
+				t.Errorf("want initial part %s, but got %s", want, line)
+			}
+			break
+		}
+	}
+	close(release)
+
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"label":"items"`,
+		`"path":["repository","issues","nodes",0]`,
+		`"data":{"projectItems":{"nodes":[{"id":"PVTI_1"}]}}`,
+		`"hasNext":false`,
+	} {
+		if !strings.Contains(string(rest), want) {
+			t.Errorf("response does not contain %s:\n%s", want, rest)
+		}
+	}
+}
+
+// postComplexity は、server.goと同じくコストの上限と報告を設定したサーバーにクエリを投げる
+func postComplexity(t *testing.T, sm *services.MockServices, limit int, query string) string {
+	t.Helper()
+
+	h := handler.New(internal.NewExecutableSchema(internal.Config{
+		Resolvers: &graph.Resolver{
+			Srv:     sm,
+			Loaders: graph.NewLoaders(sm),
+		},
+		Complexity: graph.ComplexityConfig(srvs.DefaultMaxPageSize),
+	}))
+	h.AddTransport(transport.POST{})
+	h.SetErrorPresenter(graph.ErrorPresenter)
+	h.Use(extension.FixedComplexityLimit(limit))
+	h.AroundResponses(graph.ReportComplexity)
+	srv := httptest.NewServer(h)
+	t.Cleanup(func() { srv.Close() })
+
+	reqBody := bytes.Buffer{}
+	if err := json.NewEncoder(&reqBody).Encode(struct{ Query string }{query}); err != nil {
+		t.Fatal("error encode", err)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, &reqBody)
+	if err != nil {
+		t.Fatal("error new request", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal("error request", err)
+	}
+	t.Cleanup(func() { res.Body.Close() })
+
+	return getResponseBody(t, res)
+}
+
+func TestComplexityReported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetRepoByID(gomock.Any(), "REPO_1").Return(&model.Repository{ID: "REPO_1"}, nil)
+
+	var res struct {
+		Extensions struct {
+			Complexity struct {
+				Cost  int
+				Limit int
+			}
+		}
+	}
+	if err := json.Unmarshal([]byte(postComplexity(t, sm, 20, `{ node(id: "REPO_1") { id } }`)), &res); err != nil {
+		t.Fatal(err)
+	}
+	// node(1) + id(1)
+	if got := res.Extensions.Complexity; got.Cost != 2 || got.Limit != 20 {
+		t.Errorf("want cost 2 and limit 20, but got %+v", got)
+	}
+}
+
+func TestComplexityConnectionPageSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 上限を超える場合は実行されないので、サービスは呼ばれない
+	sm := services.NewMockServices(ctrl)
+
+	// repository(1) + pullRequests(10 * (nodes(1) + id(1) + number(1)))
+	got := postComplexity(t, sm, 20, `{ repository(owner: "hsaki", name: "repo1") { pullRequests(first: 10) { nodes { id number } } } }`)
+	if !strings.Contains(got, "operation has complexity 31, which exceeds the limit of 20") {
+		t.Errorf("unexpected response: %s", got)
+	}
+}
+
+func TestComplexityNodeCountsChildren(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	sm := services.NewMockServices(ctrl)
+
+	// node(1) + issues(100 * (nodes(1) + projectItems(100 * (nodes(1) + id(1)))))
+	got := postComplexity(t, sm, 1000, `{ node(id: "REPO_1") { ... on Repository { issues(first: 100) { nodes { projectItems(first: 100) { nodes { id } } } } } } }`)
+	if !strings.Contains(got, "operation has complexity 20101, which exceeds the limit of 1000") {
+		t.Errorf("unexpected response: %s", got)
+	}
+}
+
+func TestComplexityPageSizeIsClamped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	sm := services.NewMockServices(ctrl)
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			// 負のページサイズで、隣のフィールドのコストを打ち消せない
+			// a: repository(1) + pullRequests(10 * 3), b: repository(1) + issues(1 * (nodes(1) + id(1)))
+			name:  "negative first",
+			query: `{ a: repository(owner: "hsaki", name: "repo1") { pullRequests(first: 10) { nodes { id number } } } b: repository(owner: "hsaki", name: "repo1") { issues(first: -100000) { nodes { id } } } }`,
+			want:  "operation has complexity 34, which exceeds the limit of 20",
+		},
+		{
+			name:  "negative last",
+			query: `{ a: repository(owner: "hsaki", name: "repo1") { pullRequests(first: 10) { nodes { id number } } } b: repository(owner: "hsaki", name: "repo1") { issues(last: -100000) { nodes { id } } } }`,
+			want:  "operation has complexity 34, which exceeds the limit of 20",
+		},
+		{
+			// 上限を超えるページサイズは、上限の分だけ数える
+			// repository(1) + pullRequests(100 * (nodes(1) + id(1)))
+			name:  "too large first",
+			query: `{ repository(owner: "hsaki", name: "repo1") { pullRequests(first: 1000000) { nodes { id } } } }`,
+			want:  "operation has complexity 201, which exceeds the limit of 20",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := postComplexity(t, sm, 20, tt.query); !strings.Contains(got, tt.want) {
+				t.Errorf("unexpected response: %s", got)
+			}
+		})
+	}
+}
+
+func TestComplexityNodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	sm := services.NewMockServices(ctrl)
+
+	// nodes(1)
+	var res struct {
+		Extensions struct {
+			Complexity struct {
+				Cost int
+			}
+		}
+	}
+	if err := json.Unmarshal([]byte(postComplexity(t, sm, 20, `{ nodes(ids: []) { id } }`)), &res); err != nil {
+		t.Fatal(err)
+	}
+	if got := res.Extensions.Complexity.Cost; got != 1 {
+		t.Errorf("want cost 1 for an empty list, but got %d", got)
+	}
+
+	// 上限を超えるIDの数は、上限の分だけ数える
+	// nodes(1) + 100 * id(1)
+	got := postComplexity(t, sm, 20, `{ nodes(ids: [`+nodeIDs(1000)+`]) { id } }`)
+	if !strings.Contains(got, "operation has complexity 101, which exceeds the limit of 20") {
+		t.Errorf("unexpected response: %s", got)
+	}
+}
+
+func TestNodesTooManyIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	// 上限を超えるIDの数はバリデーションエラーにする。サービスは呼ばれない
+	sm := services.NewMockServices(ctrl)
+
+	got := postComplexity(t, sm, 1000, `{ nodes(ids: [`+nodeIDs(srvs.DefaultMaxPageSize+1)+`]) { id } }`)
+	for _, want := range []string{"requesting 101 nodes exceeds the limit of 100", `"VALIDATION"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("response does not contain %s:\n%s", want, got)
+		}
+	}
+}
+
+// nodeIDs は、n個のIssueのIDをクエリに埋め込める形で返す
+func nodeIDs(n int) string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf(`"ISSUE_%d"`, i+1)
+	}
+	return strings.Join(ids, ", ")
+}
+
+func TestRateLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().GetRepoByID(gomock.Any(), "REPO_1").Return(&model.Repository{ID: "REPO_1"}, nil).Times(2)
+
+	expectToken(sm, "pat_hsaki", &model.User{ID: "U_1", Name: "hsaki"}).Times(2)
+	expectToken(sm, "pat_other", &model.User{ID: "U_2", Name: "other"})
+
+	// 認証済みユーザーは4、未認証は1だけ予算を持つ
+	limiter := ratelimit.New(4, 1, time.Hour)
+	h := handler.New(internal.NewExecutableSchema(internal.Config{
+		Resolvers: &graph.Resolver{
+			Srv:     sm,
+			Loaders: graph.NewLoaders(sm),
+		},
+		Complexity: graph.ComplexityConfig(srvs.DefaultMaxPageSize),
+	}))
+	h.AddTransport(transport.POST{})
+	h.Use(limiter)
+	srv := httptest.NewServer(limiter.Middleware(auth.AuthMiddleware(sm, h)))
+	t.Cleanup(func() { srv.Close() })
+
+	post := func(token string) (*http.Response, string) {
+		t.Helper()
+		// node(1 + id(1)) + rateLimit(1 + remaining(1)) = 4
+		reqBody := strings.NewReader(`{"query": "{ node(id: \"REPO_1\") { id } rateLimit { remaining } }"}`)
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, reqBody)
+		if err != nil {
+			t.Fatal("error new request", err)
+		}
+		req.Header.Add("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Add("Authorization", token)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal("error request", err)
+		}
+		t.Cleanup(func() { res.Body.Close() })
+		return res, getResponseBody(t, res)
+	}
+
+	res, body := post("pat_hsaki")
+	if !strings.Contains(body, `"remaining": 0`) {
+		t.Errorf("unexpected response: %s", body)
+	}
+	if got := res.Header.Get("X-RateLimit-Remaining"); got != "0" {
+		t.Errorf("want X-RateLimit-Remaining 0, but got %q", got)
+	}
+	if got := res.Header.Get("X-RateLimit-Limit"); got != "4" {
+		t.Errorf("want X-RateLimit-Limit 4, but got %q", got)
+	}
+
+	// 予算を使い切った後は実行されない
+	_, body = post("pat_hsaki")
+	if !strings.Contains(body, "RATE_LIMITED") {
+		t.Errorf("want RATE_LIMITED, but got %s", body)
+	}
+
+	// 未認証の場合はIPごとの少ない予算が使われる
+	res, body = post("")
+	if !strings.Contains(body, "RATE_LIMITED") {
+		t.Errorf("want RATE_LIMITED, but got %s", body)
+	}
+	if got := res.Header.Get("X-RateLimit-Limit"); got != "1" {
+		t.Errorf("want X-RateLimit-Limit 1, but got %q", got)
+	}
+
+	// 他のユーザーの予算は別に数える
+	_, body = post("pat_other")
+	if !strings.Contains(body, `"remaining": 0`) {
+		t.Errorf("unexpected response: %s", body)
+	}
+}
+
+func TestQueryLimits(t *testing.T) {
+	limits := querylimit.Limits{
+		MaxDepth:      5,
+		MaxAliases:    3,
+		MaxRootFields: 3,
+		MaxTokens:     200,
+		MaxBodyBytes:  4096,
+	}
+	ext := querylimit.New(limits)
+
+	// 上限を超えるクエリは実行されないので、サービスは呼ばれない
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+	sm := services.NewMockServices(ctrl)
+
+	h := handler.New(internal.NewExecutableSchema(internal.Config{Resolvers: &graph.Resolver{
+		Srv:     sm,
+		Loaders: graph.NewLoaders(sm),
+	}}))
+	h.AddTransport(transport.POST{})
+	h.Use(ext)
+	srv := httptest.NewServer(ext.BodyLimit(h))
+	t.Cleanup(func() { srv.Close() })
+
+	post := func(query string) (*http.Response, string) {
+		t.Helper()
+		reqBody := bytes.Buffer{}
+		if err := json.NewEncoder(&reqBody).Encode(struct{ Query string }{query}); err != nil {
+			t.Fatal("error encode", err)
+		}
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, &reqBody)
+		if err != nil {
+			t.Fatal("error new request", err)
+		}
+		req.Header.Add("Content-Type", "application/json")
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal("error request", err)
+		}
+		t.Cleanup(func() { res.Body.Close() })
+		return res, getResponseBody(t, res)
+	}
+
+	repeat := func(format string, n int) string {
+		var b strings.Builder
+		for i := 0; i < n; i++ {
+			fmt.Fprintf(&b, format, i)
+		}
+		return b.String()
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "deep nesting",
+			query: `{ __schema { types { fields { type { ofType { ofType { name } } } } } } }`,
+			want:  "MAX_DEPTH_EXCEEDED",
+		},
+		{
+			name: "deep nesting through fragments",
+			query: `{ __schema { types { ...A } } }
+				fragment A on __Type { fields { ...B } }
+				fragment B on __Field { type { ...C } }
+				fragment C on __Type { ofType { ofType { name } } }`,
+			want: "MAX_DEPTH_EXCEEDED",
+		},
+		{
+			name:  "many aliases",
+			query: "{" + repeat(" a%d: __typename", 4) + " }",
+			want:  "MAX_ALIASES_EXCEEDED",
+		},
+		{
+			name: "aliases hidden in a fragment",
+			query: `{ __schema { ...F } }
+				fragment F on __Schema {` + repeat(" t%d: types { name }", 4) + " }",
+			want: "MAX_ALIASES_EXCEEDED",
+		},
+		{
+			name:  "many root fields",
+			query: "{" + strings.Repeat(" __typename", 4) + " }",
+			want:  "MAX_ROOT_FIELDS_EXCEEDED",
+		},
+		{
+			name:  "root fields spread from fragments",
+			query: `{ ...Q ...Q } fragment Q on Query { __typename __schema { description } }`,
+			want:  "MAX_ROOT_FIELDS_EXCEEDED",
+		},
+		{
+			name:  "too many tokens",
+			query: "{ __typename" + strings.Repeat(" @skip(if: false)", 50) + " }",
+			want:  "MAX_TOKENS_EXCEEDED",
+		},
+		{
+			name:  "within limits",
+			query: `{ a: __typename __schema { queryType { name } } }`,
+			want:  `"a": "Query"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, body := post(tt.query); !strings.Contains(body, tt.want) {
+				t.Errorf("want %s, but got %s", tt.want, body)
+			}
+		})
+	}
+
+	t.Run("large body", func(t *testing.T) {
+		res, body := post("{ __typename }" + strings.Repeat(" ", 4096))
+		if res.StatusCode != http.StatusRequestEntityTooLarge {
+			t.Errorf("want status %d, but got %d", http.StatusRequestEntityTooLarge, res.StatusCode)
+		}
+		if !strings.Contains(body, "request body exceeds the maximum of 4096 bytes") {
+			t.Errorf("unexpected response: %s", body)
+		}
+	})
+}
+
+func TestInvalidTokenUnauthorized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	sm := services.NewMockServices(ctrl)
+	sm.EXPECT().AuthenticateToken(gomock.Any(), "pat_revoked").Return(nil, nil, apperrors.New(apperrors.Unauthenticated, "invalid token"))
+
+	h := handler.New(internal.NewExecutableSchema(internal.Config{Resolvers: &graph.Resolver{
+		Srv:     sm,
+		Loaders: graph.NewLoaders(sm),
+	}}))
+	h.AddTransport(transport.POST{})
+	srv := httptest.NewServer(auth.AuthMiddleware(sm, h))
+	t.Cleanup(func() { srv.Close() })
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, strings.NewReader(`{"query": "{ __typename }"}`))
+	if err != nil {
+		t.Fatal("error new request", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", "Bearer pat_revoked")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal("error request", err)
+	}
+	t.Cleanup(func() { res.Body.Close() })
+
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("want status %d, but got %d", http.StatusUnauthorized, res.StatusCode)
+	}
+	if got := res.Header.Get("WWW-Authenticate"); !strings.HasPrefix(got, "Bearer ") {
+		t.Errorf("unexpected WWW-Authenticate: %q", got)
+	}
+	var body struct {
+		Errors []struct {
+			Message    string
+			Extensions map[string]interface{}
+		}
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if len(body.Errors) != 1 || body.Errors[0].Extensions["code"] != "UNAUTHENTICATED" {
+		t.Errorf("unexpected response: %+v", body)
+	}
+}
@@ -16,18 +16,64 @@ PRAGMA foreign_keys = ON;
 
 CREATE TABLE IF NOT EXISTS users(\
 	id TEXT PRIMARY KEY NOT NULL,\
-	name TEXT NOT NULL,\
-	project_v2 TEXT\
+	name TEXT NOT NULL UNIQUE,\
+	project_v2 TEXT,\
+	password_hash TEXT,\
+	is_site_admin BOOLEAN NOT NULL DEFAULT FALSE\
+);
+
+CREATE TABLE IF NOT EXISTS user_blocks(\
+	blocker TEXT NOT NULL,\
+	blocked TEXT NOT NULL,\
+	created_at DATETIME NOT NULL,\
+	PRIMARY KEY (blocker, blocked),\
+	CHECK (blocker <> blocked),\
+	FOREIGN KEY (blocker) REFERENCES users(id),\
+	FOREIGN KEY (blocked) REFERENCES users(id)\
 );
 
 CREATE TABLE IF NOT EXISTS repositories(\
 	id TEXT PRIMARY KEY NOT NULL,\
 	owner TEXT NOT NULL,\
 	name TEXT NOT NULL,\
+	visibility TEXT NOT NULL DEFAULT 'PUBLIC',\
 	created_at DATETIME NOT NULL DEFAULT (DATETIME('now','localtime')),\
+	CHECK (visibility IN ('PUBLIC', 'PRIVATE')),\
 	FOREIGN KEY (owner) REFERENCES users(id)\
 );
 
+CREATE TABLE IF NOT EXISTS repository_interaction_limits(\
+	repository TEXT PRIMARY KEY NOT NULL,\
+	interaction_limit TEXT NOT NULL,\
+	expires_at DATETIME NOT NULL,\
+	CHECK (interaction_limit IN ('CONTRIBUTORS_ONLY', 'COLLABORATORS_ONLY')),\
+	FOREIGN KEY (repository) REFERENCES repositories(id)\
+);
+
+CREATE TABLE IF NOT EXISTS collaborators(\
+	repository TEXT NOT NULL,\
+	collaborator TEXT NOT NULL,\
+	permission TEXT NOT NULL,\
+	PRIMARY KEY (repository, collaborator),\
+	CHECK (permission IN ('READ', 'TRIAGE', 'WRITE', 'ADMIN')),\
+	FOREIGN KEY (repository) REFERENCES repositories(id),\
+	FOREIGN KEY (collaborator) REFERENCES users(id)\
+);
+
+CREATE TABLE IF NOT EXISTS repository_invitations(\
+	id TEXT PRIMARY KEY NOT NULL,\
+	repository TEXT NOT NULL,\
+	invitee TEXT NOT NULL,\
+	inviter TEXT NOT NULL,\
+	permission TEXT NOT NULL,\
+	created_at DATETIME NOT NULL DEFAULT (DATETIME('now','localtime')),\
+	UNIQUE (repository, invitee),\
+	CHECK (permission IN ('READ', 'TRIAGE', 'WRITE', 'ADMIN')),\
+	FOREIGN KEY (repository) REFERENCES repositories(id),\
+	FOREIGN KEY (invitee) REFERENCES users(id),\
+	FOREIGN KEY (inviter) REFERENCES users(id)\
+);
+
 CREATE TABLE IF NOT EXISTS issues(\
 	id TEXT PRIMARY KEY NOT NULL,\
 	url TEXT NOT NULL,\
@@ -36,6 +82,7 @@ CREATE TABLE IF NOT EXISTS issues(\
 	number INTEGER NOT NULL,\
 	author TEXT NOT NULL,\
 	repository TEXT NOT NULL,\
+	UNIQUE (repository, number),\
 	CHECK (closed IN (0, 1)),\
 	FOREIGN KEY (repository) REFERENCES repositories(id),\
 	FOREIGN KEY (author) REFERENCES users(id)\
@@ -67,11 +114,85 @@ CREATE TABLE IF NOT EXISTS projectcards(\
 	project TEXT NOT NULL,\
 	issue TEXT,\
 	pullrequest TEXT,\
+	archived INTEGER NOT NULL DEFAULT 0,\
+	CHECK (archived IN (0, 1)),\
 	FOREIGN KEY (project) REFERENCES projects(id),\
 	FOREIGN KEY (issue) REFERENCES issues(id),\
 	FOREIGN KEY (pullrequest) REFERENCES pullrequests(id),\
-	CHECK (issue IS NOT NULL OR pullrequest IS NOT NULL)\
+	CHECK (issue IS NOT NULL OR pullrequest IS NOT NULL),\
+	UNIQUE (project, issue),\
+	UNIQUE (project, pullrequest)\
 );
+
+CREATE TABLE IF NOT EXISTS persisted_queries(\
+	hash TEXT PRIMARY KEY NOT NULL,\
+	query TEXT NOT NULL,\
+	use_count INTEGER NOT NULL DEFAULT 0,\
+	created_at DATETIME NOT NULL DEFAULT (DATETIME('now','localtime')),\
+	last_used_at DATETIME NOT NULL DEFAULT (DATETIME('now','localtime'))\
+);
+
+CREATE TABLE IF NOT EXISTS personal_access_tokens(\
+	id TEXT PRIMARY KEY NOT NULL,\
+	owner TEXT NOT NULL,\
+	name TEXT NOT NULL,\
+	token_hash TEXT NOT NULL UNIQUE,\
+	scopes TEXT NOT NULL DEFAULT '',\
+	created_at DATETIME NOT NULL,\
+	expires_at DATETIME,\
+	last_used_at DATETIME,\
+	revoked_at DATETIME,\
+	FOREIGN KEY (owner) REFERENCES users(id)\
+);
+
+CREATE TABLE IF NOT EXISTS sessions(\
+	id TEXT PRIMARY KEY NOT NULL,\
+	owner TEXT NOT NULL,\
+	token_hash TEXT NOT NULL UNIQUE,\
+	csrf_token TEXT NOT NULL,\
+	created_at DATETIME NOT NULL,\
+	expires_at DATETIME NOT NULL,\
+	FOREIGN KEY (owner) REFERENCES users(id)\
+);
+
+CREATE TABLE IF NOT EXISTS impersonations(\
+	id TEXT PRIMARY KEY NOT NULL,\
+	admin TEXT NOT NULL,\
+	target TEXT NOT NULL,\
+	operation_name TEXT NOT NULL DEFAULT '',\
+	created_at DATETIME NOT NULL,\
+	FOREIGN KEY (admin) REFERENCES users(id),\
+	FOREIGN KEY (target) REFERENCES users(id)\
+);
+
+CREATE TABLE IF NOT EXISTS audit_logs(\
+	id TEXT PRIMARY KEY NOT NULL,\
+	actor TEXT,\
+	impersonator TEXT,\
+	operation_name TEXT NOT NULL DEFAULT '',\
+	variables TEXT NOT NULL DEFAULT '{}',\
+	outcome TEXT NOT NULL,\
+	duration_ms INTEGER NOT NULL,\
+	created_at DATETIME NOT NULL,\
+	CHECK (outcome IN ('SUCCESS', 'USER_ERROR', 'ERROR'))\
+);
+
+CREATE TABLE IF NOT EXISTS audit_log_nodes(\
+	audit_log TEXT NOT NULL,\
+	node_id TEXT NOT NULL,\
+	PRIMARY KEY (audit_log, node_id),\
+	FOREIGN KEY (audit_log) REFERENCES audit_logs(id)\
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_nodes_node_id ON audit_log_nodes(node_id);
+
+CREATE TRIGGER IF NOT EXISTS audit_logs_no_update BEFORE UPDATE ON audit_logs BEGIN SELECT RAISE(ABORT, 'audit_logs is append-only'); END;
+
+CREATE TRIGGER IF NOT EXISTS audit_logs_no_delete BEFORE DELETE ON audit_logs BEGIN SELECT RAISE(ABORT, 'audit_logs is append-only'); END;
+
+CREATE TRIGGER IF NOT EXISTS audit_log_nodes_no_update BEFORE UPDATE ON audit_log_nodes BEGIN SELECT RAISE(ABORT, 'audit_log_nodes is append-only'); END;
+
+CREATE TRIGGER IF NOT EXISTS audit_log_nodes_no_delete BEFORE DELETE ON audit_log_nodes BEGIN SELECT RAISE(ABORT, 'audit_log_nodes is append-only'); END;
 "
 
 # Insert initial data
@@ -79,12 +200,15 @@ echo "inserting initial data..."
 sqlite3 ${DBFILE_NAME} "
 PRAGMA foreign_keys = ON;
 
-INSERT INTO users(id, name) VALUES\
-	('U_1', 'hsaki')
+-- hsakiには平文を公開している開発用のトークンがあるので、サイトの管理者にはしない
+INSERT INTO users(id, name, is_site_admin) VALUES\
+	('U_1', 'hsaki', FALSE),\
+	('U_2', 'octocat', FALSE)
 ;
 
-INSERT INTO repositories(id, owner, name) VALUES\
-	('REPO_1', 'U_1', 'repo1')
+INSERT INTO repositories(id, owner, name, visibility) VALUES\
+	('REPO_1', 'U_1', 'repo1', 'PUBLIC'),\
+	('REPO_2', 'U_1', 'secret', 'PRIVATE')
 ;
 
 INSERT INTO issues(id, url, title, closed, number, author, repository) VALUES\
@@ -106,4 +230,9 @@ INSERT INTO pullrequests(id, base_ref_name, closed, head_ref_name, url, number,
 	('PR_1', 'main', 1, 'feature/kinou1', 'http://example.com/repo1/pr/1', 1, 'REPO_1'),\
 	('PR_2', 'main', 0, 'feature/kinou2', 'http://example.com/repo1/pr/2', 2, 'REPO_1')\
 ;
+
+-- 開発用のトークン(pat_hsaki_development_token)のハッシュ
+INSERT INTO personal_access_tokens(id, owner, name, token_hash, scopes, created_at) VALUES\
+	('PAT_1', 'U_1', 'development', '36c4884aa52518f3ff64533d281c222cdf012bc564227d4c4354edf1df67e7f7', 'repo:write project user', DATETIME('now','localtime'))\
+;
 "